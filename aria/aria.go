@@ -0,0 +1,350 @@
+// Package aria provides typed constructors for the WAI-ARIA 1.2 roles,
+// states and properties. All functions return attributes.Attribute values,
+// so they can be mixed with the attributes of htmlgo/attributes:
+//
+//	Div(Attr(a.Id_("menu"), aria.Role(aria.RoleMenu), aria.LabelledBy("menu-title")))
+//
+// See https://www.w3.org/TR/wai-aria-1.2/ for the semantics of each value.
+package aria
+
+import (
+	"strconv"
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// Tristate is the value type of aria-checked and aria-pressed
+type Tristate string
+
+const (
+	True  Tristate = "true"
+	False Tristate = "false"
+	Mixed Tristate = "mixed"
+)
+
+// Bool converts a boolean into a Tristate
+func Bool(b bool) Tristate {
+	if b {
+		return True
+	}
+	return False
+}
+
+// AutocompleteValue is the value type of aria-autocomplete
+type AutocompleteValue string
+
+const (
+	AutocompleteInline AutocompleteValue = "inline"
+	AutocompleteList   AutocompleteValue = "list"
+	AutocompleteBoth   AutocompleteValue = "both"
+	AutocompleteNone   AutocompleteValue = "none"
+)
+
+// CurrentValue is the value type of aria-current
+type CurrentValue string
+
+const (
+	CurrentPage     CurrentValue = "page"
+	CurrentStep     CurrentValue = "step"
+	CurrentLocation CurrentValue = "location"
+	CurrentDate     CurrentValue = "date"
+	CurrentTime     CurrentValue = "time"
+	CurrentTrue     CurrentValue = "true"
+	CurrentFalse    CurrentValue = "false"
+)
+
+// DropEffectValue is the value type of the deprecated aria-dropeffect
+type DropEffectValue string
+
+const (
+	DropEffectCopy    DropEffectValue = "copy"
+	DropEffectExecute DropEffectValue = "execute"
+	DropEffectLink    DropEffectValue = "link"
+	DropEffectMove    DropEffectValue = "move"
+	DropEffectNone    DropEffectValue = "none"
+	DropEffectPopup   DropEffectValue = "popup"
+)
+
+// HasPopupValue is the value type of aria-haspopup
+type HasPopupValue string
+
+const (
+	PopupFalse   HasPopupValue = "false"
+	PopupTrue    HasPopupValue = "true"
+	PopupMenu    HasPopupValue = "menu"
+	PopupListbox HasPopupValue = "listbox"
+	PopupTree    HasPopupValue = "tree"
+	PopupGrid    HasPopupValue = "grid"
+	PopupDialog  HasPopupValue = "dialog"
+)
+
+// InvalidValue is the value type of aria-invalid
+type InvalidValue string
+
+const (
+	InvalidFalse    InvalidValue = "false"
+	InvalidTrue     InvalidValue = "true"
+	InvalidGrammar  InvalidValue = "grammar"
+	InvalidSpelling InvalidValue = "spelling"
+)
+
+// LiveValue is the value type of aria-live
+type LiveValue string
+
+const (
+	LiveOff       LiveValue = "off"
+	LivePolite    LiveValue = "polite"
+	LiveAssertive LiveValue = "assertive"
+)
+
+// OrientationValue is the value type of aria-orientation
+type OrientationValue string
+
+const (
+	OrientationHorizontal OrientationValue = "horizontal"
+	OrientationVertical   OrientationValue = "vertical"
+	OrientationUndefined  OrientationValue = "undefined"
+)
+
+// RelevantValue is a token of aria-relevant
+type RelevantValue string
+
+const (
+	RelevantAdditions RelevantValue = "additions"
+	RelevantRemovals  RelevantValue = "removals"
+	RelevantText      RelevantValue = "text"
+	RelevantAll       RelevantValue = "all"
+)
+
+// SortValue is the value type of aria-sort
+type SortValue string
+
+const (
+	SortAscending  SortValue = "ascending"
+	SortDescending SortValue = "descending"
+	SortNone       SortValue = "none"
+	SortOther      SortValue = "other"
+)
+
+// idRefs joins ID references into a space-separated list. IDs must not
+// contain whitespace, so any whitespace splits an argument into several IDs.
+func idRefs(ids []string) string {
+	return strings.Join(strings.Fields(strings.Join(ids, " ")), " ")
+}
+
+func boolString(b bool) string {
+	return strconv.FormatBool(b)
+}
+
+func intString(i int) string {
+	return strconv.Itoa(i)
+}
+
+func floatString(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// Begin of states and properties
+
+func ActiveDescendant(id string) a.Attribute {
+	return a.AriaActivedescendant(idRefs([]string{id}))
+}
+
+func Atomic(b bool) a.Attribute {
+	return a.AriaAtomic(boolString(b))
+}
+
+func Autocomplete(v AutocompleteValue) a.Attribute {
+	return a.AriaAutocomplete(string(v))
+}
+
+func Busy(b bool) a.Attribute {
+	return a.AriaBusy(boolString(b))
+}
+
+func Checked(v Tristate) a.Attribute {
+	return a.AriaChecked(string(v))
+}
+
+func ColCount(n int) a.Attribute {
+	return a.AriaColcount(intString(n))
+}
+
+func ColIndex(n int) a.Attribute {
+	return a.AriaColindex(intString(n))
+}
+
+func ColSpan(n int) a.Attribute {
+	return a.AriaColspan(intString(n))
+}
+
+func Controls(ids ...string) a.Attribute {
+	return a.AriaControls(idRefs(ids))
+}
+
+func Current(v CurrentValue) a.Attribute {
+	return a.AriaCurrent(string(v))
+}
+
+func DescribedBy(ids ...string) a.Attribute {
+	return a.AriaDescribedby(idRefs(ids))
+}
+
+func Details(id string) a.Attribute {
+	return a.AriaDetails(idRefs([]string{id}))
+}
+
+func Disabled(b bool) a.Attribute {
+	return a.AriaDisabled(boolString(b))
+}
+
+// DropEffect is deprecated in ARIA 1.2
+func DropEffect(vs ...DropEffectValue) a.Attribute {
+	tokens := make([]string, len(vs))
+	for i, v := range vs {
+		tokens[i] = string(v)
+	}
+	return a.AriaDropeffect(strings.Join(tokens, " "))
+}
+
+func ErrorMessage(id string) a.Attribute {
+	return a.AriaErrormessage(idRefs([]string{id}))
+}
+
+func Expanded(b bool) a.Attribute {
+	return a.AriaExpanded(boolString(b))
+}
+
+func FlowTo(ids ...string) a.Attribute {
+	return a.AriaFlowto(idRefs(ids))
+}
+
+// Grabbed is deprecated in ARIA 1.2
+func Grabbed(b bool) a.Attribute {
+	return a.AriaGrabbed(boolString(b))
+}
+
+func HasPopup(v HasPopupValue) a.Attribute {
+	return a.AriaHaspopup(string(v))
+}
+
+func Hidden(b bool) a.Attribute {
+	return a.AriaHidden(boolString(b))
+}
+
+func Invalid(v InvalidValue) a.Attribute {
+	return a.AriaInvalid(string(v))
+}
+
+func KeyShortcuts(shortcuts ...string) a.Attribute {
+	return a.AriaKeyshortcuts(strings.Join(shortcuts, " "))
+}
+
+func Label(s string) a.Attribute {
+	return a.AriaLabel(s)
+}
+
+func LabelledBy(ids ...string) a.Attribute {
+	return a.AriaLabelledby(idRefs(ids))
+}
+
+func Level(n int) a.Attribute {
+	return a.AriaLevel(intString(n))
+}
+
+func Live(v LiveValue) a.Attribute {
+	return a.AriaLive(string(v))
+}
+
+func Modal(b bool) a.Attribute {
+	return a.AriaModal(boolString(b))
+}
+
+func Multiline(b bool) a.Attribute {
+	return a.AriaMultiline(boolString(b))
+}
+
+func Multiselectable(b bool) a.Attribute {
+	return a.AriaMultiselectable(boolString(b))
+}
+
+func Orientation(v OrientationValue) a.Attribute {
+	return a.AriaOrientation(string(v))
+}
+
+func Owns(ids ...string) a.Attribute {
+	return a.AriaOwns(idRefs(ids))
+}
+
+func Placeholder(s string) a.Attribute {
+	return a.AriaPlaceholder(s)
+}
+
+func PosInSet(n int) a.Attribute {
+	return a.AriaPosinset(intString(n))
+}
+
+func Pressed(v Tristate) a.Attribute {
+	return a.AriaPressed(string(v))
+}
+
+func ReadOnly(b bool) a.Attribute {
+	return a.AriaReadonly(boolString(b))
+}
+
+func Relevant(vs ...RelevantValue) a.Attribute {
+	tokens := make([]string, len(vs))
+	for i, v := range vs {
+		tokens[i] = string(v)
+	}
+	return a.AriaRelevant(strings.Join(tokens, " "))
+}
+
+func Required(b bool) a.Attribute {
+	return a.AriaRequired(boolString(b))
+}
+
+func RoleDescription(s string) a.Attribute {
+	return a.AriaRoledescription(s)
+}
+
+func RowCount(n int) a.Attribute {
+	return a.AriaRowcount(intString(n))
+}
+
+func RowIndex(n int) a.Attribute {
+	return a.AriaRowindex(intString(n))
+}
+
+func RowSpan(n int) a.Attribute {
+	return a.AriaRowspan(intString(n))
+}
+
+func Selected(b bool) a.Attribute {
+	return a.AriaSelected(boolString(b))
+}
+
+func SetSize(n int) a.Attribute {
+	return a.AriaSetsize(intString(n))
+}
+
+func Sort(v SortValue) a.Attribute {
+	return a.AriaSort(string(v))
+}
+
+func ValueMax(f float64) a.Attribute {
+	return a.AriaValuemax(floatString(f))
+}
+
+func ValueMin(f float64) a.Attribute {
+	return a.AriaValuemin(floatString(f))
+}
+
+func ValueNow(f float64) a.Attribute {
+	return a.AriaValuenow(floatString(f))
+}
+
+func ValueText(s string) a.Attribute {
+	return a.AriaValuetext(s)
+}
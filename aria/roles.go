@@ -0,0 +1,158 @@
+package aria
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// RoleName is one of the non-abstract roles of WAI-ARIA 1.2
+type RoleName string
+
+const (
+	RoleAlert            RoleName = "alert"
+	RoleAlertdialog      RoleName = "alertdialog"
+	RoleApplication      RoleName = "application"
+	RoleArticle          RoleName = "article"
+	RoleBanner           RoleName = "banner"
+	RoleBlockquote       RoleName = "blockquote"
+	RoleButton           RoleName = "button"
+	RoleCaption          RoleName = "caption"
+	RoleCell             RoleName = "cell"
+	RoleCheckbox         RoleName = "checkbox"
+	RoleCode             RoleName = "code"
+	RoleColumnheader     RoleName = "columnheader"
+	RoleCombobox         RoleName = "combobox"
+	RoleComplementary    RoleName = "complementary"
+	RoleContentinfo      RoleName = "contentinfo"
+	RoleDefinition       RoleName = "definition"
+	RoleDeletion         RoleName = "deletion"
+	RoleDialog           RoleName = "dialog"
+	RoleDocument         RoleName = "document"
+	RoleEmphasis         RoleName = "emphasis"
+	RoleFeed             RoleName = "feed"
+	RoleFigure           RoleName = "figure"
+	RoleForm             RoleName = "form"
+	RoleGeneric          RoleName = "generic"
+	RoleGrid             RoleName = "grid"
+	RoleGridcell         RoleName = "gridcell"
+	RoleGroup            RoleName = "group"
+	RoleHeading          RoleName = "heading"
+	RoleImg              RoleName = "img"
+	RoleInsertion        RoleName = "insertion"
+	RoleLink             RoleName = "link"
+	RoleList             RoleName = "list"
+	RoleListbox          RoleName = "listbox"
+	RoleListitem         RoleName = "listitem"
+	RoleLog              RoleName = "log"
+	RoleMain             RoleName = "main"
+	RoleMarquee          RoleName = "marquee"
+	RoleMath             RoleName = "math"
+	RoleMenu             RoleName = "menu"
+	RoleMenubar          RoleName = "menubar"
+	RoleMenuitem         RoleName = "menuitem"
+	RoleMenuitemcheckbox RoleName = "menuitemcheckbox"
+	RoleMenuitemradio    RoleName = "menuitemradio"
+	RoleMeter            RoleName = "meter"
+	RoleNavigation       RoleName = "navigation"
+	RoleNone             RoleName = "none"
+	RoleNote             RoleName = "note"
+	RoleOption           RoleName = "option"
+	RoleParagraph        RoleName = "paragraph"
+	RolePresentation     RoleName = "presentation"
+	RoleProgressbar      RoleName = "progressbar"
+	RoleRadio            RoleName = "radio"
+	RoleRadiogroup       RoleName = "radiogroup"
+	RoleRegion           RoleName = "region"
+	RoleRow              RoleName = "row"
+	RoleRowgroup         RoleName = "rowgroup"
+	RoleRowheader        RoleName = "rowheader"
+	RoleScrollbar        RoleName = "scrollbar"
+	RoleSearch           RoleName = "search"
+	RoleSearchbox        RoleName = "searchbox"
+	RoleSeparator        RoleName = "separator"
+	RoleSlider           RoleName = "slider"
+	RoleSpinbutton       RoleName = "spinbutton"
+	RoleStatus           RoleName = "status"
+	RoleStrong           RoleName = "strong"
+	RoleSubscript        RoleName = "subscript"
+	RoleSuperscript      RoleName = "superscript"
+	RoleSwitch           RoleName = "switch"
+	RoleTab              RoleName = "tab"
+	RoleTable            RoleName = "table"
+	RoleTablist          RoleName = "tablist"
+	RoleTabpanel         RoleName = "tabpanel"
+	RoleTerm             RoleName = "term"
+	RoleTextbox          RoleName = "textbox"
+	RoleTime             RoleName = "time"
+	RoleTimer            RoleName = "timer"
+	RoleToolbar          RoleName = "toolbar"
+	RoleTooltip          RoleName = "tooltip"
+	RoleTree             RoleName = "tree"
+	RoleTreegrid         RoleName = "treegrid"
+	RoleTreeitem         RoleName = "treeitem"
+)
+
+// Role sets the role attribute. Fallback roles are appended in order, for
+// user agents which do not support the first role.
+func Role(name RoleName, fallbacks ...RoleName) a.Attribute {
+	tokens := []string{string(name)}
+	for _, f := range fallbacks {
+		tokens = append(tokens, string(f))
+	}
+	return a.Role(strings.Join(tokens, " "))
+}
+
+// requiredStates lists the states and properties ARIA 1.2 requires authors
+// to provide for a role, see the "Required States and Properties" of each
+// role in https://www.w3.org/TR/wai-aria-1.2/#role_definitions
+var requiredStates = map[RoleName][]string{
+	RoleCheckbox:         {"aria-checked"},
+	RoleCombobox:         {"aria-controls", "aria-expanded"},
+	RoleHeading:          {"aria-level"},
+	RoleMenuitemcheckbox: {"aria-checked"},
+	RoleMenuitemradio:    {"aria-checked"},
+	RoleMeter:            {"aria-valuenow"},
+	RoleRadio:            {"aria-checked"},
+	RoleScrollbar:        {"aria-controls", "aria-valuenow"},
+	RoleSlider:           {"aria-valuenow"},
+	RoleSwitch:           {"aria-checked"},
+}
+
+// RequiredStates returns the states and properties which must be present on
+// an element with the given role
+func RequiredStates(name RoleName) []string {
+	return append([]string(nil), requiredStates[name]...)
+}
+
+// Validate checks that the attributes of an element provide all states and
+// properties required by its role. Only the first role token is considered,
+// as fallback roles must not be required to be supported. Attributes without
+// a role always validate. Note that states implied by native semantics, such
+// as the checkedness of an input, are not taken into account.
+func Validate(attrs []a.Attribute) error {
+	present := map[string]string{}
+	for _, attr := range a.Render(attrs) {
+		present[strings.ToLower(attr.Name)] = attr.Value
+	}
+
+	roles := strings.Fields(present["role"])
+	if len(roles) == 0 {
+		return nil
+	}
+	role := RoleName(roles[0])
+
+	var missing []string
+	for _, state := range requiredStates[role] {
+		if _, ok := present[state]; !ok {
+			missing = append(missing, state)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("aria: role %q requires %s", role, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -3,2615 +3,3945 @@ package attributes
 import "strings"
 
 type Attribute struct {
-    Templ       string
-    Data        interface{}
-    Name        string
+	Templ string
+	Data  interface{}
+	Name  string
 }
 
 // Begin of manually implemented attributes
 
 func Dataset(key, value string) Attribute {
-		key_ := strings.Replace(key, "-", "_", -1)
-    return Attribute{
-        Data: map[string]string{
-            "key":      key,
-            "value":    value,
-        },
-        Templ: `{{define "Dataset_`+key_+`"}}data-{{.key}}="{{.value}}"{{end}}`,
-        Name: "Dataset_"+key_,
-    }
+	key_ := strings.Replace(key, "-", "_", -1)
+	return Attribute{
+		Data: map[string]string{
+			"key":   key,
+			"value": value,
+		},
+		Templ: `{{define "Dataset_` + key_ + `"}}data-{{.key}}="{{.value}}"{{end}}`,
+		Name:  "Dataset_" + key_,
+	}
 }
 
 func Dataset_(key, value string) Attribute {
-		key_ := strings.Replace(key, "-", "_", -1)
-    return Attribute{
-        Data: map[string]string{
-					"value": value,
-				},
-        Templ: `{{define "Dataset_`+key_+`"}}data-`+key+`="{{.value}}"{{end}}`,
-        Name: "Dataset_"+key_,
-    }
+	key_ := strings.Replace(key, "-", "_", -1)
+	return Attribute{
+		Data: map[string]string{
+			"value": value,
+		},
+		Templ: `{{define "Dataset_` + key_ + `"}}data-` + key + `="{{.value}}"{{end}}`,
+		Name:  "Dataset_" + key_,
+	}
+}
+
+// Custom produces an attribute of any name, e.g. for XML vocabularies such
+// as Atom. Like other attributes, its value is escaped according to the
+// attribute's name, e.g. URLs are filtered for href. Names may consist of
+// letters, digits and _ : . -, otherwise the attribute is not rendered.
+func Custom(name string, data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Custom_" + name}
+	if !isCustomName(name) {
+		return attr
+	}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Custom_` + name + `"}}` + name + `="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Custom_` + name + `"}}` + name + `="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Custom_(name string, values ...string) Attribute {
+	return Custom(name, nil, values...)
+}
+
+func isCustomName(name string) bool {
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == ':':
+		case (c >= '0' && c <= '9' || c == '.' || c == '-') && i > 0:
+		default:
+			return false
+		}
+	}
+	return name != ""
 }
 
 // Begin of generated attributes
 
+func Abbr(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Abbr"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Abbr"}}abbr="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Abbr"}}abbr="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Abbr_(values ...string) Attribute {
+	return Abbr(nil, values...)
+}
 
 func Accept(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Accept" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Accept"}}accept="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Accept"}}accept="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Accept"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Accept"}}accept="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Accept"}}accept="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Accept_(values ...string) Attribute {
-    return Accept(nil, values...)
+	return Accept(nil, values...)
 }
 
-
 func AcceptCharset(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "AcceptCharset" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "AcceptCharset"}}accept-charset="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "AcceptCharset"}}accept-charset="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "AcceptCharset"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AcceptCharset"}}accept-charset="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AcceptCharset"}}accept-charset="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func AcceptCharset_(values ...string) Attribute {
-    return AcceptCharset(nil, values...)
+	return AcceptCharset(nil, values...)
 }
 
-
 func Accesskey(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Accesskey" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Accesskey"}}accesskey="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Accesskey"}}accesskey="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Accesskey"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Accesskey"}}accesskey="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Accesskey"}}accesskey="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Accesskey_(values ...string) Attribute {
-    return Accesskey(nil, values...)
+	return Accesskey(nil, values...)
 }
 
-
 func Action(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Action" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Action"}}action="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Action"}}action="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Action"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Action"}}action="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Action"}}action="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Action_(values ...string) Attribute {
-    return Action(nil, values...)
+	return Action(nil, values...)
 }
 
+func Allow(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Allow"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Allow"}}allow="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Allow"}}allow="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Align(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Align" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Align"}}align="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Align"}}align="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Allow_(values ...string) Attribute {
+	return Allow(nil, values...)
 }
 
-func Align_(values ...string) Attribute {
-    return Align(nil, values...)
+func Allowfullscreen(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Allowfullscreen"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Allowfullscreen"}}allowfullscreen="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Allowfullscreen"}}allowfullscreen="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Allowfullscreen_(values ...string) Attribute {
+	return Allowfullscreen(nil, values...)
+}
 
 func Alt(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Alt" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Alt"}}alt="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Alt"}}alt="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Alt"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Alt"}}alt="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Alt"}}alt="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Alt_(values ...string) Attribute {
-    return Alt(nil, values...)
+	return Alt(nil, values...)
 }
 
-
-func AriaExpanded(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "AriaExpanded" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "AriaExpanded"}}aria-expanded="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "AriaExpanded"}}aria-expanded="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func As(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "As"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "As"}}as="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "As"}}as="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func AriaExpanded_(values ...string) Attribute {
-    return AriaExpanded(nil, values...)
+func As_(values ...string) Attribute {
+	return As(nil, values...)
 }
 
-
-func AriaHidden(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "AriaHidden" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "AriaHidden"}}aria-hidden="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "AriaHidden"}}aria-hidden="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Async(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Async"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Async"}}async="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Async"}}async="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func AriaHidden_(values ...string) Attribute {
-    return AriaHidden(nil, values...)
+func Async_(values ...string) Attribute {
+	return Async(nil, values...)
 }
 
-
-func AriaLabel(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "AriaLabel" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "AriaLabel"}}aria-label="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "AriaLabel"}}aria-label="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Autocapitalize(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Autocapitalize"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Autocapitalize"}}autocapitalize="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Autocapitalize"}}autocapitalize="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func AriaLabel_(values ...string) Attribute {
-    return AriaLabel(nil, values...)
+func Autocapitalize_(values ...string) Attribute {
+	return Autocapitalize(nil, values...)
 }
 
-
-func Async(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Async" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Async"}}async="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Async"}}async="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Autocomplete(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Autocomplete"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Autocomplete"}}autocomplete="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Autocomplete"}}autocomplete="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Async_(values ...string) Attribute {
-    return Async(nil, values...)
+func Autocomplete_(values ...string) Attribute {
+	return Autocomplete(nil, values...)
 }
 
-
-func Autocomplete(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Autocomplete" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Autocomplete"}}autocomplete="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Autocomplete"}}autocomplete="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Autocorrect(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Autocorrect"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Autocorrect"}}autocorrect="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Autocorrect"}}autocorrect="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Autocomplete_(values ...string) Attribute {
-    return Autocomplete(nil, values...)
+func Autocorrect_(values ...string) Attribute {
+	return Autocorrect(nil, values...)
 }
 
-
 func Autofocus(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Autofocus" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Autofocus"}}autofocus="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Autofocus"}}autofocus="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Autofocus"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Autofocus"}}autofocus="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Autofocus"}}autofocus="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Autofocus_(values ...string) Attribute {
-    return Autofocus(nil, values...)
+	return Autofocus(nil, values...)
 }
 
-
 func Autoplay(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Autoplay" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Autoplay"}}autoplay="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Autoplay"}}autoplay="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Autoplay"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Autoplay"}}autoplay="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Autoplay"}}autoplay="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Autoplay_(values ...string) Attribute {
-    return Autoplay(nil, values...)
-}
-
-
-func Bgcolor(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Bgcolor" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Bgcolor"}}bgcolor="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Bgcolor"}}bgcolor="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	return Autoplay(nil, values...)
 }
 
-func Bgcolor_(values ...string) Attribute {
-    return Bgcolor(nil, values...)
-}
-
-
-func Border(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Border" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Border"}}border="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Border"}}border="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Blocking(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Blocking"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Blocking"}}blocking="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Blocking"}}blocking="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Border_(values ...string) Attribute {
-    return Border(nil, values...)
+func Blocking_(values ...string) Attribute {
+	return Blocking(nil, values...)
 }
 
-
 func Charset(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Charset" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Charset"}}charset="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Charset"}}charset="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Charset"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Charset"}}charset="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Charset"}}charset="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Charset_(values ...string) Attribute {
-    return Charset(nil, values...)
+	return Charset(nil, values...)
 }
 
-
 func Checked(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Checked" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Checked"}}checked="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Checked"}}checked="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Checked"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Checked"}}checked="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Checked"}}checked="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Checked_(values ...string) Attribute {
-    return Checked(nil, values...)
+	return Checked(nil, values...)
 }
 
-
 func Cite(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Cite" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Cite"}}cite="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Cite"}}cite="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Cite"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Cite"}}cite="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Cite"}}cite="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Cite_(values ...string) Attribute {
-    return Cite(nil, values...)
+	return Cite(nil, values...)
 }
 
-
 func Class(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Class" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Class"}}class="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Class"}}class="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Class"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Class"}}class="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Class"}}class="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Class_(values ...string) Attribute {
-    return Class(nil, values...)
+	return Class(nil, values...)
 }
 
+func Closedby(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Closedby"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Closedby"}}closedby="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Closedby"}}closedby="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Closedby_(values ...string) Attribute {
+	return Closedby(nil, values...)
+}
 
 func Color(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Color" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Color"}}color="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Color"}}color="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Color"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Color"}}color="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Color"}}color="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Color_(values ...string) Attribute {
-    return Color(nil, values...)
+	return Color(nil, values...)
 }
 
-
 func Cols(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Cols" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Cols"}}cols="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Cols"}}cols="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Cols"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Cols"}}cols="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Cols"}}cols="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Cols_(values ...string) Attribute {
-    return Cols(nil, values...)
+	return Cols(nil, values...)
 }
 
-
 func Colspan(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Colspan" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Colspan"}}colspan="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Colspan"}}colspan="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Colspan"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Colspan"}}colspan="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Colspan"}}colspan="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Colspan_(values ...string) Attribute {
-    return Colspan(nil, values...)
+	return Colspan(nil, values...)
+}
+
+func Command(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Command"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Command"}}command="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Command"}}command="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Command_(values ...string) Attribute {
+	return Command(nil, values...)
+}
+
+func Commandfor(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Commandfor"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Commandfor"}}commandfor="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Commandfor"}}commandfor="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Commandfor_(values ...string) Attribute {
+	return Commandfor(nil, values...)
+}
 
 func Content(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Content" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Content"}}content="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Content"}}content="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Content"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Content"}}content="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Content"}}content="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Content_(values ...string) Attribute {
-    return Content(nil, values...)
+	return Content(nil, values...)
 }
 
-
 func Contenteditable(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Contenteditable" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Contenteditable"}}contenteditable="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Contenteditable"}}contenteditable="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Contenteditable"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Contenteditable"}}contenteditable="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Contenteditable"}}contenteditable="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Contenteditable_(values ...string) Attribute {
-    return Contenteditable(nil, values...)
+	return Contenteditable(nil, values...)
 }
 
-
 func Controls(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Controls" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Controls"}}controls="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Controls"}}controls="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Controls"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Controls"}}controls="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Controls"}}controls="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Controls_(values ...string) Attribute {
-    return Controls(nil, values...)
+	return Controls(nil, values...)
 }
 
-
 func Coords(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Coords" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Coords"}}coords="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Coords"}}coords="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Coords"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Coords"}}coords="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Coords"}}coords="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Coords_(values ...string) Attribute {
-    return Coords(nil, values...)
+	return Coords(nil, values...)
 }
 
+func Crossorigin(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Crossorigin"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Crossorigin"}}crossorigin="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Crossorigin"}}crossorigin="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Crossorigin_(values ...string) Attribute {
+	return Crossorigin(nil, values...)
+}
 
 func Data(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Data" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Data"}}data="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Data"}}data="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Data"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Data"}}data="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Data"}}data="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Data_(values ...string) Attribute {
-    return Data(nil, values...)
+	return Data(nil, values...)
 }
 
-
 func Datetime(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Datetime" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Datetime"}}datetime="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Datetime"}}datetime="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Datetime"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Datetime"}}datetime="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Datetime"}}datetime="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Datetime_(values ...string) Attribute {
-    return Datetime(nil, values...)
+	return Datetime(nil, values...)
 }
 
+func Decoding(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Decoding"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Decoding"}}decoding="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Decoding"}}decoding="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Decoding_(values ...string) Attribute {
+	return Decoding(nil, values...)
+}
 
 func Default(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Default" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Default"}}default="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Default"}}default="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Default"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Default"}}default="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Default"}}default="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Default_(values ...string) Attribute {
-    return Default(nil, values...)
+	return Default(nil, values...)
 }
 
-
 func Defer(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Defer" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Defer"}}defer="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Defer"}}defer="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Defer"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Defer"}}defer="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Defer"}}defer="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Defer_(values ...string) Attribute {
-    return Defer(nil, values...)
+	return Defer(nil, values...)
 }
 
-
 func Dir(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Dir" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Dir"}}dir="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Dir"}}dir="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Dir"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Dir"}}dir="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Dir"}}dir="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Dir_(values ...string) Attribute {
-    return Dir(nil, values...)
+	return Dir(nil, values...)
 }
 
-
 func Dirname(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Dirname" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Dirname"}}dirname="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Dirname"}}dirname="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Dirname"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Dirname"}}dirname="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Dirname"}}dirname="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Dirname_(values ...string) Attribute {
-    return Dirname(nil, values...)
+	return Dirname(nil, values...)
 }
 
-
 func Disabled(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Disabled" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Disabled"}}disabled="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Disabled"}}disabled="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Disabled"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Disabled"}}disabled="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Disabled"}}disabled="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Disabled_(values ...string) Attribute {
-    return Disabled(nil, values...)
+	return Disabled(nil, values...)
 }
 
-
 func Download(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Download" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Download"}}download="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Download"}}download="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Download"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Download"}}download="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Download"}}download="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Download_(values ...string) Attribute {
-    return Download(nil, values...)
+	return Download(nil, values...)
 }
 
-
 func Draggable(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Draggable" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Draggable"}}draggable="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Draggable"}}draggable="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Draggable"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Draggable"}}draggable="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Draggable"}}draggable="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Draggable_(values ...string) Attribute {
-    return Draggable(nil, values...)
+	return Draggable(nil, values...)
 }
 
-
-func Dropzone(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Dropzone" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Dropzone"}}dropzone="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Dropzone"}}dropzone="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Enctype(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Enctype"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Enctype"}}enctype="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Enctype"}}enctype="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Dropzone_(values ...string) Attribute {
-    return Dropzone(nil, values...)
+func Enctype_(values ...string) Attribute {
+	return Enctype(nil, values...)
 }
 
+func Enterkeyhint(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Enterkeyhint"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Enterkeyhint"}}enterkeyhint="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Enterkeyhint"}}enterkeyhint="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Enctype(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Enctype" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Enctype"}}enctype="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Enctype"}}enctype="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Enterkeyhint_(values ...string) Attribute {
+	return Enterkeyhint(nil, values...)
 }
 
-func Enctype_(values ...string) Attribute {
-    return Enctype(nil, values...)
+func Fetchpriority(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Fetchpriority"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Fetchpriority"}}fetchpriority="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Fetchpriority"}}fetchpriority="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Fetchpriority_(values ...string) Attribute {
+	return Fetchpriority(nil, values...)
+}
 
 func For(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "For" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "For"}}for="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "For"}}for="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "For"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "For"}}for="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "For"}}for="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func For_(values ...string) Attribute {
-    return For(nil, values...)
+	return For(nil, values...)
 }
 
-
 func Form(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Form" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Form"}}form="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Form"}}form="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Form"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Form"}}form="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Form"}}form="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Form_(values ...string) Attribute {
-    return Form(nil, values...)
+	return Form(nil, values...)
 }
 
-
 func Formaction(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Formaction" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Formaction"}}formaction="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Formaction"}}formaction="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Formaction"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Formaction"}}formaction="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Formaction"}}formaction="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Formaction_(values ...string) Attribute {
-    return Formaction(nil, values...)
+	return Formaction(nil, values...)
+}
+
+func Formenctype(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Formenctype"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Formenctype"}}formenctype="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Formenctype"}}formenctype="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Formenctype_(values ...string) Attribute {
+	return Formenctype(nil, values...)
+}
+
+func Formmethod(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Formmethod"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Formmethod"}}formmethod="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Formmethod"}}formmethod="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Formmethod_(values ...string) Attribute {
+	return Formmethod(nil, values...)
+}
+
+func Formnovalidate(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Formnovalidate"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Formnovalidate"}}formnovalidate="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Formnovalidate"}}formnovalidate="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Formnovalidate_(values ...string) Attribute {
+	return Formnovalidate(nil, values...)
+}
+
+func Formtarget(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Formtarget"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Formtarget"}}formtarget="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Formtarget"}}formtarget="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Formtarget_(values ...string) Attribute {
+	return Formtarget(nil, values...)
+}
 
 func Headers(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Headers" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Headers"}}headers="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Headers"}}headers="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Headers"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Headers"}}headers="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Headers"}}headers="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Headers_(values ...string) Attribute {
-    return Headers(nil, values...)
+	return Headers(nil, values...)
 }
 
-
 func Height(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Height" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Height"}}height="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Height"}}height="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Height"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Height"}}height="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Height"}}height="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Height_(values ...string) Attribute {
-    return Height(nil, values...)
+	return Height(nil, values...)
 }
 
-
 func Hidden(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Hidden" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Hidden"}}hidden="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Hidden"}}hidden="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Hidden"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Hidden"}}hidden="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Hidden"}}hidden="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Hidden_(values ...string) Attribute {
-    return Hidden(nil, values...)
+	return Hidden(nil, values...)
 }
 
-
 func High(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "High" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "High"}}high="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "High"}}high="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "High"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "High"}}high="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "High"}}high="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func High_(values ...string) Attribute {
-    return High(nil, values...)
+	return High(nil, values...)
 }
 
-
 func Href(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Href" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Href"}}href="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Href"}}href="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Href"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Href"}}href="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Href"}}href="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Href_(values ...string) Attribute {
-    return Href(nil, values...)
+	return Href(nil, values...)
 }
 
-
 func Hreflang(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Hreflang" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Hreflang"}}hreflang="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Hreflang"}}hreflang="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Hreflang"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Hreflang"}}hreflang="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Hreflang"}}hreflang="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Hreflang_(values ...string) Attribute {
-    return Hreflang(nil, values...)
+	return Hreflang(nil, values...)
 }
 
-
 func HttpEquiv(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "HttpEquiv" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "HttpEquiv"}}http-equiv="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "HttpEquiv"}}http-equiv="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "HttpEquiv"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "HttpEquiv"}}http-equiv="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "HttpEquiv"}}http-equiv="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func HttpEquiv_(values ...string) Attribute {
-    return HttpEquiv(nil, values...)
+	return HttpEquiv(nil, values...)
 }
 
-
 func Id(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Id" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Id"}}id="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Id"}}id="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Id"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Id"}}id="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Id"}}id="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Id_(values ...string) Attribute {
-    return Id(nil, values...)
+	return Id(nil, values...)
 }
 
+func Imagesizes(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Imagesizes"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Imagesizes"}}imagesizes="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Imagesizes"}}imagesizes="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func InitialScale(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "InitialScale" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "InitialScale"}}initial-scale="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "InitialScale"}}initial-scale="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Imagesizes_(values ...string) Attribute {
+	return Imagesizes(nil, values...)
 }
 
-func InitialScale_(values ...string) Attribute {
-    return InitialScale(nil, values...)
+func Imagesrcset(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Imagesrcset"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Imagesrcset"}}imagesrcset="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Imagesrcset"}}imagesrcset="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Imagesrcset_(values ...string) Attribute {
+	return Imagesrcset(nil, values...)
+}
+
+func Inert(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Inert"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Inert"}}inert="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Inert"}}inert="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Inert_(values ...string) Attribute {
+	return Inert(nil, values...)
+}
+
+func Inputmode(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Inputmode"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Inputmode"}}inputmode="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Inputmode"}}inputmode="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Inputmode_(values ...string) Attribute {
+	return Inputmode(nil, values...)
+}
+
+func Integrity(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Integrity"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Integrity"}}integrity="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Integrity"}}integrity="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Integrity_(values ...string) Attribute {
+	return Integrity(nil, values...)
+}
+
+func Is(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Is"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Is"}}is="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Is"}}is="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Is_(values ...string) Attribute {
+	return Is(nil, values...)
+}
 
 func Ismap(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ismap" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ismap"}}ismap="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ismap"}}ismap="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ismap"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ismap"}}ismap="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ismap"}}ismap="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ismap_(values ...string) Attribute {
-    return Ismap(nil, values...)
+	return Ismap(nil, values...)
 }
 
+func Itemid(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Itemid"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Itemid"}}itemid="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Itemid"}}itemid="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Itemid_(values ...string) Attribute {
+	return Itemid(nil, values...)
+}
+
+func Itemprop(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Itemprop"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Itemprop"}}itemprop="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Itemprop"}}itemprop="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Itemprop_(values ...string) Attribute {
+	return Itemprop(nil, values...)
+}
+
+func Itemref(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Itemref"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Itemref"}}itemref="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Itemref"}}itemref="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Itemref_(values ...string) Attribute {
+	return Itemref(nil, values...)
+}
+
+func Itemscope(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Itemscope"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Itemscope"}}itemscope="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Itemscope"}}itemscope="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Itemscope_(values ...string) Attribute {
+	return Itemscope(nil, values...)
+}
+
+func Itemtype(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Itemtype"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Itemtype"}}itemtype="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Itemtype"}}itemtype="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Itemtype_(values ...string) Attribute {
+	return Itemtype(nil, values...)
+}
 
 func Kind(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Kind" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Kind"}}kind="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Kind"}}kind="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Kind"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Kind"}}kind="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Kind"}}kind="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Kind_(values ...string) Attribute {
-    return Kind(nil, values...)
+	return Kind(nil, values...)
 }
 
-
 func Label(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Label" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Label"}}label="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Label"}}label="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Label"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Label"}}label="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Label"}}label="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Label_(values ...string) Attribute {
-    return Label(nil, values...)
+	return Label(nil, values...)
 }
 
-
 func Lang(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Lang" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Lang"}}lang="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Lang"}}lang="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Lang"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Lang"}}lang="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Lang"}}lang="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Lang_(values ...string) Attribute {
-    return Lang(nil, values...)
+	return Lang(nil, values...)
 }
 
-
 func List(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "List" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "List"}}list="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "List"}}list="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "List"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "List"}}list="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "List"}}list="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func List_(values ...string) Attribute {
-    return List(nil, values...)
+	return List(nil, values...)
 }
 
+func Loading(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Loading"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Loading"}}loading="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Loading"}}loading="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Loading_(values ...string) Attribute {
+	return Loading(nil, values...)
+}
 
 func Loop(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Loop" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Loop"}}loop="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Loop"}}loop="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Loop"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Loop"}}loop="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Loop"}}loop="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Loop_(values ...string) Attribute {
-    return Loop(nil, values...)
+	return Loop(nil, values...)
 }
 
-
 func Low(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Low" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Low"}}low="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Low"}}low="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Low"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Low"}}low="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Low"}}low="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Low_(values ...string) Attribute {
-    return Low(nil, values...)
+	return Low(nil, values...)
 }
 
-
 func Max(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Max" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Max"}}max="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Max"}}max="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Max"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Max"}}max="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Max"}}max="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Max_(values ...string) Attribute {
-    return Max(nil, values...)
+	return Max(nil, values...)
 }
 
-
 func Maxlength(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Maxlength" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Maxlength"}}maxlength="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Maxlength"}}maxlength="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Maxlength"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Maxlength"}}maxlength="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Maxlength"}}maxlength="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Maxlength_(values ...string) Attribute {
-    return Maxlength(nil, values...)
+	return Maxlength(nil, values...)
 }
 
-
 func Media(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Media" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Media"}}media="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Media"}}media="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Media"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Media"}}media="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Media"}}media="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Media_(values ...string) Attribute {
-    return Media(nil, values...)
+	return Media(nil, values...)
 }
 
-
 func Method(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Method" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Method"}}method="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Method"}}method="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Method"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Method"}}method="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Method"}}method="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Method_(values ...string) Attribute {
-    return Method(nil, values...)
+	return Method(nil, values...)
 }
 
-
 func Min(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Min" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Min"}}min="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Min"}}min="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Min"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Min"}}min="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Min"}}min="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Min_(values ...string) Attribute {
-    return Min(nil, values...)
+	return Min(nil, values...)
 }
 
+func Minlength(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Minlength"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Minlength"}}minlength="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Minlength"}}minlength="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Minlength_(values ...string) Attribute {
+	return Minlength(nil, values...)
+}
 
 func Multiple(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Multiple" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Multiple"}}multiple="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Multiple"}}multiple="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Multiple"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Multiple"}}multiple="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Multiple"}}multiple="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Multiple_(values ...string) Attribute {
+	return Multiple(nil, values...)
+}
+
+func Muted(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Muted"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Muted"}}muted="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Muted"}}muted="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Muted_(values ...string) Attribute {
+	return Muted(nil, values...)
+}
+
+func Name(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Name"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Name"}}name="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Name"}}name="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Name_(values ...string) Attribute {
+	return Name(nil, values...)
+}
+
+func Nomodule(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Nomodule"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Nomodule"}}nomodule="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Nomodule"}}nomodule="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Nomodule_(values ...string) Attribute {
+	return Nomodule(nil, values...)
+}
+
+func Nonce(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Nonce"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Nonce"}}nonce="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Nonce"}}nonce="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Nonce_(values ...string) Attribute {
+	return Nonce(nil, values...)
+}
+
+func Novalidate(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Novalidate"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Novalidate"}}novalidate="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Novalidate"}}novalidate="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Novalidate_(values ...string) Attribute {
+	return Novalidate(nil, values...)
+}
+
+func Open(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Open"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Open"}}open="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Open"}}open="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Open_(values ...string) Attribute {
+	return Open(nil, values...)
+}
+
+func Optimum(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Optimum"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Optimum"}}optimum="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Optimum"}}optimum="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Optimum_(values ...string) Attribute {
+	return Optimum(nil, values...)
+}
+
+func Pattern(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Pattern"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Pattern"}}pattern="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Pattern"}}pattern="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Pattern_(values ...string) Attribute {
+	return Pattern(nil, values...)
+}
+
+func Ping(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Ping"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ping"}}ping="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ping"}}ping="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Ping_(values ...string) Attribute {
+	return Ping(nil, values...)
+}
+
+func Placeholder(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Placeholder"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Placeholder"}}placeholder="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Placeholder"}}placeholder="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Placeholder_(values ...string) Attribute {
+	return Placeholder(nil, values...)
+}
+
+func Playsinline(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Playsinline"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Playsinline"}}playsinline="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Playsinline"}}playsinline="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Playsinline_(values ...string) Attribute {
+	return Playsinline(nil, values...)
+}
+
+func Popover(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Popover"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Popover"}}popover="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Popover"}}popover="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Popover_(values ...string) Attribute {
+	return Popover(nil, values...)
+}
+
+func Popovertarget(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Popovertarget"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Popovertarget"}}popovertarget="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Popovertarget"}}popovertarget="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Popovertarget_(values ...string) Attribute {
+	return Popovertarget(nil, values...)
+}
+
+func Popovertargetaction(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Popovertargetaction"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Popovertargetaction"}}popovertargetaction="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Popovertargetaction"}}popovertargetaction="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Popovertargetaction_(values ...string) Attribute {
+	return Popovertargetaction(nil, values...)
+}
+
+func Poster(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Poster"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Poster"}}poster="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Poster"}}poster="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Poster_(values ...string) Attribute {
+	return Poster(nil, values...)
+}
+
+func Preload(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Preload"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Preload"}}preload="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Preload"}}preload="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Preload_(values ...string) Attribute {
+	return Preload(nil, values...)
+}
+
+func Readonly(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Readonly"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Readonly"}}readonly="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Readonly"}}readonly="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Readonly_(values ...string) Attribute {
+	return Readonly(nil, values...)
+}
+
+func Referrerpolicy(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Referrerpolicy"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Referrerpolicy"}}referrerpolicy="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Referrerpolicy"}}referrerpolicy="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Referrerpolicy_(values ...string) Attribute {
+	return Referrerpolicy(nil, values...)
+}
+
+func Rel(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Rel"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Rel"}}rel="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Rel"}}rel="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Rel_(values ...string) Attribute {
+	return Rel(nil, values...)
+}
+
+func Required(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Required"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Required"}}required="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Required"}}required="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Required_(values ...string) Attribute {
+	return Required(nil, values...)
+}
+
+func Reversed(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Reversed"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Reversed"}}reversed="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Reversed"}}reversed="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Reversed_(values ...string) Attribute {
+	return Reversed(nil, values...)
+}
+
+func Role(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Role"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Role"}}role="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Role"}}role="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Role_(values ...string) Attribute {
+	return Role(nil, values...)
+}
+
+func Rows(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Rows"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Rows"}}rows="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Rows"}}rows="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Rows_(values ...string) Attribute {
+	return Rows(nil, values...)
+}
+
+func Rowspan(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Rowspan"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Rowspan"}}rowspan="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Rowspan"}}rowspan="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Rowspan_(values ...string) Attribute {
+	return Rowspan(nil, values...)
+}
+
+func Sandbox(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Sandbox"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Sandbox"}}sandbox="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Sandbox"}}sandbox="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Sandbox_(values ...string) Attribute {
+	return Sandbox(nil, values...)
+}
+
+func Scope(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Scope"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Scope"}}scope="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Scope"}}scope="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Scope_(values ...string) Attribute {
+	return Scope(nil, values...)
+}
+
+func Selected(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Selected"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Selected"}}selected="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Selected"}}selected="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Selected_(values ...string) Attribute {
+	return Selected(nil, values...)
+}
+
+func Shadowrootclonable(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Shadowrootclonable"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Shadowrootclonable"}}shadowrootclonable="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Shadowrootclonable"}}shadowrootclonable="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Shadowrootclonable_(values ...string) Attribute {
+	return Shadowrootclonable(nil, values...)
+}
+
+func Shadowrootdelegatesfocus(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Shadowrootdelegatesfocus"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Shadowrootdelegatesfocus"}}shadowrootdelegatesfocus="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Shadowrootdelegatesfocus"}}shadowrootdelegatesfocus="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Shadowrootdelegatesfocus_(values ...string) Attribute {
+	return Shadowrootdelegatesfocus(nil, values...)
+}
+
+func Shadowrootmode(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Shadowrootmode"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Shadowrootmode"}}shadowrootmode="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Shadowrootmode"}}shadowrootmode="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Shadowrootmode_(values ...string) Attribute {
+	return Shadowrootmode(nil, values...)
+}
+
+func Shadowrootserializable(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Shadowrootserializable"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Shadowrootserializable"}}shadowrootserializable="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Shadowrootserializable"}}shadowrootserializable="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Shadowrootserializable_(values ...string) Attribute {
+	return Shadowrootserializable(nil, values...)
+}
+
+func Shape(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Shape"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Shape"}}shape="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Shape"}}shape="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Shape_(values ...string) Attribute {
+	return Shape(nil, values...)
+}
+
+func Size(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Size"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Size"}}size="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Size"}}size="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Size_(values ...string) Attribute {
+	return Size(nil, values...)
+}
+
+func Sizes(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Sizes"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Sizes"}}sizes="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Sizes"}}sizes="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Sizes_(values ...string) Attribute {
+	return Sizes(nil, values...)
+}
+
+func Slot(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Slot"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Slot"}}slot="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Slot"}}slot="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Slot_(values ...string) Attribute {
+	return Slot(nil, values...)
+}
+
+func Span(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Span"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Span"}}span="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Span"}}span="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Span_(values ...string) Attribute {
+	return Span(nil, values...)
+}
+
+func Spellcheck(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Spellcheck"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Spellcheck"}}spellcheck="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Spellcheck"}}spellcheck="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Spellcheck_(values ...string) Attribute {
+	return Spellcheck(nil, values...)
+}
+
+func Src(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Src"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Src"}}src="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Src"}}src="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Src_(values ...string) Attribute {
+	return Src(nil, values...)
+}
+
+func Srcdoc(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Srcdoc"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Srcdoc"}}srcdoc="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Srcdoc"}}srcdoc="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Srcdoc_(values ...string) Attribute {
+	return Srcdoc(nil, values...)
+}
+
+func Srclang(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Srclang"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Srclang"}}srclang="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Srclang"}}srclang="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Srclang_(values ...string) Attribute {
+	return Srclang(nil, values...)
+}
+
+func Srcset(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Srcset"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Srcset"}}srcset="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Srcset"}}srcset="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Srcset_(values ...string) Attribute {
+	return Srcset(nil, values...)
+}
+
+func Start(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Start"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Start"}}start="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Start"}}start="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Start_(values ...string) Attribute {
+	return Start(nil, values...)
+}
+
+func Step(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Step"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Step"}}step="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Step"}}step="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Step_(values ...string) Attribute {
+	return Step(nil, values...)
+}
+
+func Style(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Style"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Style"}}style="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Style"}}style="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Style_(values ...string) Attribute {
+	return Style(nil, values...)
+}
+
+func Tabindex(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Tabindex"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Tabindex"}}tabindex="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Tabindex"}}tabindex="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Tabindex_(values ...string) Attribute {
+	return Tabindex(nil, values...)
+}
+
+func Target(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Target"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Target"}}target="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Target"}}target="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Target_(values ...string) Attribute {
+	return Target(nil, values...)
+}
+
+func Title(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Title"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Title"}}title="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Title"}}title="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Title_(values ...string) Attribute {
+	return Title(nil, values...)
+}
+
+func Translate(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Translate"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Translate"}}translate="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Translate"}}translate="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Translate_(values ...string) Attribute {
+	return Translate(nil, values...)
+}
+
+func Type(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Type"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Type"}}type="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Type"}}type="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Type_(values ...string) Attribute {
+	return Type(nil, values...)
+}
+
+func Usemap(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Usemap"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Usemap"}}usemap="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Usemap"}}usemap="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Usemap_(values ...string) Attribute {
+	return Usemap(nil, values...)
+}
+
+func Value(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Value"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Value"}}value="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Value"}}value="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Value_(values ...string) Attribute {
+	return Value(nil, values...)
 }
 
-func Multiple_(values ...string) Attribute {
-    return Multiple(nil, values...)
+func Width(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Width"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Width"}}width="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Width"}}width="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-
-func Muted(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Muted" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Muted"}}muted="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Muted"}}muted="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Width_(values ...string) Attribute {
+	return Width(nil, values...)
 }
 
-func Muted_(values ...string) Attribute {
-    return Muted(nil, values...)
+func Wrap(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Wrap"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Wrap"}}wrap="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Wrap"}}wrap="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Wrap_(values ...string) Attribute {
+	return Wrap(nil, values...)
+}
 
-func Name(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Name" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Name"}}name="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Name"}}name="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Writingsuggestions(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Writingsuggestions"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Writingsuggestions"}}writingsuggestions="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Writingsuggestions"}}writingsuggestions="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Name_(values ...string) Attribute {
-    return Name(nil, values...)
+func Writingsuggestions_(values ...string) Attribute {
+	return Writingsuggestions(nil, values...)
 }
 
+func Onabort(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onabort"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onabort"}}onabort="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onabort"}}onabort="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Novalidate(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Novalidate" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Novalidate"}}novalidate="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Novalidate"}}novalidate="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Onabort_(values ...string) Attribute {
+	return Onabort(nil, values...)
 }
 
-func Novalidate_(values ...string) Attribute {
-    return Novalidate(nil, values...)
+func Onafterprint(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onafterprint"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onafterprint"}}onafterprint="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onafterprint"}}onafterprint="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Onafterprint_(values ...string) Attribute {
+	return Onafterprint(nil, values...)
+}
 
-func Onabort(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onabort" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onabort"}}onabort="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onabort"}}onabort="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Onauxclick(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onauxclick"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onauxclick"}}onauxclick="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onauxclick"}}onauxclick="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Onabort_(values ...string) Attribute {
-    return Onabort(nil, values...)
+func Onauxclick_(values ...string) Attribute {
+	return Onauxclick(nil, values...)
 }
 
+func Onbeforeinput(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onbeforeinput"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onbeforeinput"}}onbeforeinput="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onbeforeinput"}}onbeforeinput="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Onafterprint(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onafterprint" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onafterprint"}}onafterprint="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onafterprint"}}onafterprint="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Onbeforeinput_(values ...string) Attribute {
+	return Onbeforeinput(nil, values...)
 }
 
-func Onafterprint_(values ...string) Attribute {
-    return Onafterprint(nil, values...)
+func Onbeforematch(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onbeforematch"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onbeforematch"}}onbeforematch="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onbeforematch"}}onbeforematch="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Onbeforematch_(values ...string) Attribute {
+	return Onbeforematch(nil, values...)
+}
 
 func Onbeforeprint(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onbeforeprint" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onbeforeprint"}}onbeforeprint="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onbeforeprint"}}onbeforeprint="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onbeforeprint"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onbeforeprint"}}onbeforeprint="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onbeforeprint"}}onbeforeprint="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onbeforeprint_(values ...string) Attribute {
-    return Onbeforeprint(nil, values...)
+	return Onbeforeprint(nil, values...)
+}
+
+func Onbeforetoggle(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onbeforetoggle"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onbeforetoggle"}}onbeforetoggle="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onbeforetoggle"}}onbeforetoggle="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Onbeforetoggle_(values ...string) Attribute {
+	return Onbeforetoggle(nil, values...)
+}
 
 func Onbeforeunload(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onbeforeunload" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onbeforeunload"}}onbeforeunload="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onbeforeunload"}}onbeforeunload="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onbeforeunload"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onbeforeunload"}}onbeforeunload="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onbeforeunload"}}onbeforeunload="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onbeforeunload_(values ...string) Attribute {
-    return Onbeforeunload(nil, values...)
+	return Onbeforeunload(nil, values...)
 }
 
-
 func Onblur(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onblur" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onblur"}}onblur="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onblur"}}onblur="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onblur"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onblur"}}onblur="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onblur"}}onblur="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onblur_(values ...string) Attribute {
-    return Onblur(nil, values...)
+	return Onblur(nil, values...)
+}
+
+func Oncancel(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Oncancel"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oncancel"}}oncancel="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oncancel"}}oncancel="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Oncancel_(values ...string) Attribute {
+	return Oncancel(nil, values...)
+}
 
 func Oncanplay(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Oncanplay" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Oncanplay"}}oncanplay="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Oncanplay"}}oncanplay="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Oncanplay"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oncanplay"}}oncanplay="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oncanplay"}}oncanplay="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Oncanplay_(values ...string) Attribute {
-    return Oncanplay(nil, values...)
+	return Oncanplay(nil, values...)
 }
 
-
 func Oncanplaythrough(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Oncanplaythrough" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Oncanplaythrough"}}oncanplaythrough="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Oncanplaythrough"}}oncanplaythrough="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Oncanplaythrough"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oncanplaythrough"}}oncanplaythrough="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oncanplaythrough"}}oncanplaythrough="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Oncanplaythrough_(values ...string) Attribute {
-    return Oncanplaythrough(nil, values...)
+	return Oncanplaythrough(nil, values...)
 }
 
-
 func Onchange(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onchange" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onchange"}}onchange="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onchange"}}onchange="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onchange"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onchange"}}onchange="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onchange"}}onchange="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onchange_(values ...string) Attribute {
-    return Onchange(nil, values...)
+	return Onchange(nil, values...)
 }
 
-
 func Onclick(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onclick" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onclick"}}onclick="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onclick"}}onclick="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onclick"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onclick"}}onclick="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onclick"}}onclick="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onclick_(values ...string) Attribute {
-    return Onclick(nil, values...)
+	return Onclick(nil, values...)
+}
+
+func Onclose(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onclose"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onclose"}}onclose="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onclose"}}onclose="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Onclose_(values ...string) Attribute {
+	return Onclose(nil, values...)
+}
+
+func Oncommand(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Oncommand"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oncommand"}}oncommand="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oncommand"}}oncommand="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Oncommand_(values ...string) Attribute {
+	return Oncommand(nil, values...)
+}
+
+func Oncontextlost(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Oncontextlost"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oncontextlost"}}oncontextlost="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oncontextlost"}}oncontextlost="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Oncontextlost_(values ...string) Attribute {
+	return Oncontextlost(nil, values...)
+}
 
 func Oncontextmenu(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Oncontextmenu" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Oncontextmenu"}}oncontextmenu="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Oncontextmenu"}}oncontextmenu="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Oncontextmenu"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oncontextmenu"}}oncontextmenu="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oncontextmenu"}}oncontextmenu="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Oncontextmenu_(values ...string) Attribute {
-    return Oncontextmenu(nil, values...)
+	return Oncontextmenu(nil, values...)
+}
+
+func Oncontextrestored(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Oncontextrestored"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oncontextrestored"}}oncontextrestored="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oncontextrestored"}}oncontextrestored="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Oncontextrestored_(values ...string) Attribute {
+	return Oncontextrestored(nil, values...)
+}
 
 func Oncopy(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Oncopy" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Oncopy"}}oncopy="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Oncopy"}}oncopy="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Oncopy"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oncopy"}}oncopy="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oncopy"}}oncopy="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Oncopy_(values ...string) Attribute {
-    return Oncopy(nil, values...)
+	return Oncopy(nil, values...)
 }
 
-
 func Oncuechange(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Oncuechange" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Oncuechange"}}oncuechange="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Oncuechange"}}oncuechange="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Oncuechange"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oncuechange"}}oncuechange="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oncuechange"}}oncuechange="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Oncuechange_(values ...string) Attribute {
-    return Oncuechange(nil, values...)
+	return Oncuechange(nil, values...)
 }
 
-
 func Oncut(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Oncut" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Oncut"}}oncut="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Oncut"}}oncut="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Oncut"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oncut"}}oncut="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oncut"}}oncut="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Oncut_(values ...string) Attribute {
-    return Oncut(nil, values...)
+	return Oncut(nil, values...)
 }
 
-
 func Ondblclick(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ondblclick" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ondblclick"}}ondblclick="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ondblclick"}}ondblclick="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ondblclick"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ondblclick"}}ondblclick="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ondblclick"}}ondblclick="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ondblclick_(values ...string) Attribute {
-    return Ondblclick(nil, values...)
+	return Ondblclick(nil, values...)
 }
 
-
 func Ondrag(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ondrag" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ondrag"}}ondrag="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ondrag"}}ondrag="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ondrag"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ondrag"}}ondrag="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ondrag"}}ondrag="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ondrag_(values ...string) Attribute {
-    return Ondrag(nil, values...)
+	return Ondrag(nil, values...)
 }
 
-
 func Ondragend(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ondragend" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ondragend"}}ondragend="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ondragend"}}ondragend="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ondragend"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ondragend"}}ondragend="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ondragend"}}ondragend="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ondragend_(values ...string) Attribute {
-    return Ondragend(nil, values...)
+	return Ondragend(nil, values...)
 }
 
-
 func Ondragenter(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ondragenter" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ondragenter"}}ondragenter="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ondragenter"}}ondragenter="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ondragenter"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ondragenter"}}ondragenter="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ondragenter"}}ondragenter="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ondragenter_(values ...string) Attribute {
-    return Ondragenter(nil, values...)
+	return Ondragenter(nil, values...)
 }
 
-
 func Ondragleave(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ondragleave" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ondragleave"}}ondragleave="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ondragleave"}}ondragleave="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ondragleave"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ondragleave"}}ondragleave="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ondragleave"}}ondragleave="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ondragleave_(values ...string) Attribute {
-    return Ondragleave(nil, values...)
+	return Ondragleave(nil, values...)
 }
 
-
 func Ondragover(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ondragover" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ondragover"}}ondragover="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ondragover"}}ondragover="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ondragover"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ondragover"}}ondragover="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ondragover"}}ondragover="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ondragover_(values ...string) Attribute {
-    return Ondragover(nil, values...)
+	return Ondragover(nil, values...)
 }
 
-
 func Ondragstart(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ondragstart" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ondragstart"}}ondragstart="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ondragstart"}}ondragstart="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ondragstart"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ondragstart"}}ondragstart="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ondragstart"}}ondragstart="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ondragstart_(values ...string) Attribute {
-    return Ondragstart(nil, values...)
+	return Ondragstart(nil, values...)
 }
 
-
 func Ondrop(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ondrop" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ondrop"}}ondrop="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ondrop"}}ondrop="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ondrop"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ondrop"}}ondrop="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ondrop"}}ondrop="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ondrop_(values ...string) Attribute {
-    return Ondrop(nil, values...)
+	return Ondrop(nil, values...)
 }
 
-
 func Ondurationchange(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ondurationchange" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ondurationchange"}}ondurationchange="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ondurationchange"}}ondurationchange="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ondurationchange"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ondurationchange"}}ondurationchange="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ondurationchange"}}ondurationchange="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ondurationchange_(values ...string) Attribute {
-    return Ondurationchange(nil, values...)
+	return Ondurationchange(nil, values...)
 }
 
-
 func Onemptied(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onemptied" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onemptied"}}onemptied="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onemptied"}}onemptied="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onemptied"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onemptied"}}onemptied="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onemptied"}}onemptied="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onemptied_(values ...string) Attribute {
-    return Onemptied(nil, values...)
+	return Onemptied(nil, values...)
 }
 
-
 func Onended(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onended" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onended"}}onended="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onended"}}onended="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onended"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onended"}}onended="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onended"}}onended="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onended_(values ...string) Attribute {
-    return Onended(nil, values...)
+	return Onended(nil, values...)
 }
 
-
 func Onerror(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onerror" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onerror"}}onerror="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onerror"}}onerror="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onerror"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onerror"}}onerror="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onerror"}}onerror="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onerror_(values ...string) Attribute {
-    return Onerror(nil, values...)
+	return Onerror(nil, values...)
 }
 
-
 func Onfocus(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onfocus" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onfocus"}}onfocus="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onfocus"}}onfocus="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onfocus"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onfocus"}}onfocus="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onfocus"}}onfocus="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onfocus_(values ...string) Attribute {
-    return Onfocus(nil, values...)
+	return Onfocus(nil, values...)
 }
 
+func Onformdata(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onformdata"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onformdata"}}onformdata="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onformdata"}}onformdata="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Onformdata_(values ...string) Attribute {
+	return Onformdata(nil, values...)
+}
 
 func Onhashchange(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onhashchange" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onhashchange"}}onhashchange="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onhashchange"}}onhashchange="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onhashchange"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onhashchange"}}onhashchange="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onhashchange"}}onhashchange="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onhashchange_(values ...string) Attribute {
-    return Onhashchange(nil, values...)
+	return Onhashchange(nil, values...)
 }
 
-
 func Oninput(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Oninput" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Oninput"}}oninput="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Oninput"}}oninput="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Oninput"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oninput"}}oninput="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oninput"}}oninput="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Oninput_(values ...string) Attribute {
-    return Oninput(nil, values...)
+	return Oninput(nil, values...)
 }
 
-
 func Oninvalid(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Oninvalid" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Oninvalid"}}oninvalid="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Oninvalid"}}oninvalid="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Oninvalid"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Oninvalid"}}oninvalid="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Oninvalid"}}oninvalid="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Oninvalid_(values ...string) Attribute {
-    return Oninvalid(nil, values...)
+	return Oninvalid(nil, values...)
 }
 
-
 func Onkeydown(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onkeydown" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onkeydown"}}onkeydown="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onkeydown"}}onkeydown="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onkeydown"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onkeydown"}}onkeydown="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onkeydown"}}onkeydown="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onkeydown_(values ...string) Attribute {
-    return Onkeydown(nil, values...)
+	return Onkeydown(nil, values...)
 }
 
-
 func Onkeypress(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onkeypress" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onkeypress"}}onkeypress="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onkeypress"}}onkeypress="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onkeypress"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onkeypress"}}onkeypress="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onkeypress"}}onkeypress="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onkeypress_(values ...string) Attribute {
-    return Onkeypress(nil, values...)
+	return Onkeypress(nil, values...)
 }
 
-
 func Onkeyup(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onkeyup" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onkeyup"}}onkeyup="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onkeyup"}}onkeyup="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onkeyup"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onkeyup"}}onkeyup="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onkeyup"}}onkeyup="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onkeyup_(values ...string) Attribute {
-    return Onkeyup(nil, values...)
+	return Onkeyup(nil, values...)
 }
 
+func Onlanguagechange(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onlanguagechange"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onlanguagechange"}}onlanguagechange="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onlanguagechange"}}onlanguagechange="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Onlanguagechange_(values ...string) Attribute {
+	return Onlanguagechange(nil, values...)
+}
 
 func Onload(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onload" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onload"}}onload="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onload"}}onload="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onload"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onload"}}onload="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onload"}}onload="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onload_(values ...string) Attribute {
-    return Onload(nil, values...)
+	return Onload(nil, values...)
 }
 
-
 func Onloadeddata(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onloadeddata" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onloadeddata"}}onloadeddata="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onloadeddata"}}onloadeddata="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onloadeddata"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onloadeddata"}}onloadeddata="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onloadeddata"}}onloadeddata="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onloadeddata_(values ...string) Attribute {
-    return Onloadeddata(nil, values...)
+	return Onloadeddata(nil, values...)
 }
 
-
 func Onloadedmetadata(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onloadedmetadata" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onloadedmetadata"}}onloadedmetadata="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onloadedmetadata"}}onloadedmetadata="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onloadedmetadata"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onloadedmetadata"}}onloadedmetadata="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onloadedmetadata"}}onloadedmetadata="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onloadedmetadata_(values ...string) Attribute {
-    return Onloadedmetadata(nil, values...)
+	return Onloadedmetadata(nil, values...)
 }
 
-
 func Onloadstart(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onloadstart" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onloadstart"}}onloadstart="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onloadstart"}}onloadstart="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onloadstart"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onloadstart"}}onloadstart="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onloadstart"}}onloadstart="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onloadstart_(values ...string) Attribute {
-    return Onloadstart(nil, values...)
+	return Onloadstart(nil, values...)
+}
+
+func Onmessage(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onmessage"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onmessage"}}onmessage="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onmessage"}}onmessage="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Onmessage_(values ...string) Attribute {
+	return Onmessage(nil, values...)
+}
+
+func Onmessageerror(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onmessageerror"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onmessageerror"}}onmessageerror="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onmessageerror"}}onmessageerror="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Onmessageerror_(values ...string) Attribute {
+	return Onmessageerror(nil, values...)
+}
 
 func Onmousedown(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onmousedown" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onmousedown"}}onmousedown="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onmousedown"}}onmousedown="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onmousedown"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onmousedown"}}onmousedown="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onmousedown"}}onmousedown="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onmousedown_(values ...string) Attribute {
-    return Onmousedown(nil, values...)
+	return Onmousedown(nil, values...)
+}
+
+func Onmouseenter(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onmouseenter"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onmouseenter"}}onmouseenter="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onmouseenter"}}onmouseenter="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Onmouseenter_(values ...string) Attribute {
+	return Onmouseenter(nil, values...)
+}
+
+func Onmouseleave(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onmouseleave"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onmouseleave"}}onmouseleave="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onmouseleave"}}onmouseleave="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Onmouseleave_(values ...string) Attribute {
+	return Onmouseleave(nil, values...)
+}
 
 func Onmousemove(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onmousemove" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onmousemove"}}onmousemove="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onmousemove"}}onmousemove="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onmousemove"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onmousemove"}}onmousemove="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onmousemove"}}onmousemove="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onmousemove_(values ...string) Attribute {
-    return Onmousemove(nil, values...)
+	return Onmousemove(nil, values...)
 }
 
-
 func Onmouseout(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onmouseout" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onmouseout"}}onmouseout="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onmouseout"}}onmouseout="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onmouseout"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onmouseout"}}onmouseout="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onmouseout"}}onmouseout="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onmouseout_(values ...string) Attribute {
-    return Onmouseout(nil, values...)
+	return Onmouseout(nil, values...)
 }
 
-
 func Onmouseover(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onmouseover" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onmouseover"}}onmouseover="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onmouseover"}}onmouseover="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onmouseover"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onmouseover"}}onmouseover="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onmouseover"}}onmouseover="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onmouseover_(values ...string) Attribute {
-    return Onmouseover(nil, values...)
+	return Onmouseover(nil, values...)
 }
 
-
 func Onmouseup(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onmouseup" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onmouseup"}}onmouseup="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onmouseup"}}onmouseup="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onmouseup"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onmouseup"}}onmouseup="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onmouseup"}}onmouseup="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onmouseup_(values ...string) Attribute {
-    return Onmouseup(nil, values...)
-}
-
-
-func Onmousewheel(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onmousewheel" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onmousewheel"}}onmousewheel="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onmousewheel"}}onmousewheel="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
-}
-
-func Onmousewheel_(values ...string) Attribute {
-    return Onmousewheel(nil, values...)
+	return Onmouseup(nil, values...)
 }
 
-
 func Onoffline(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onoffline" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onoffline"}}onoffline="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onoffline"}}onoffline="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onoffline"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onoffline"}}onoffline="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onoffline"}}onoffline="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onoffline_(values ...string) Attribute {
-    return Onoffline(nil, values...)
+	return Onoffline(nil, values...)
 }
 
-
 func Ononline(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ononline" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ononline"}}ononline="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ononline"}}ononline="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ononline"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ononline"}}ononline="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ononline"}}ononline="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ononline_(values ...string) Attribute {
-    return Ononline(nil, values...)
+	return Ononline(nil, values...)
 }
 
-
 func Onpagehide(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onpagehide" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onpagehide"}}onpagehide="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onpagehide"}}onpagehide="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onpagehide"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onpagehide"}}onpagehide="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onpagehide"}}onpagehide="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onpagehide_(values ...string) Attribute {
-    return Onpagehide(nil, values...)
+	return Onpagehide(nil, values...)
+}
+
+func Onpagereveal(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onpagereveal"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onpagereveal"}}onpagereveal="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onpagereveal"}}onpagereveal="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Onpagereveal_(values ...string) Attribute {
+	return Onpagereveal(nil, values...)
+}
 
 func Onpageshow(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onpageshow" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onpageshow"}}onpageshow="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onpageshow"}}onpageshow="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onpageshow"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onpageshow"}}onpageshow="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onpageshow"}}onpageshow="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onpageshow_(values ...string) Attribute {
-    return Onpageshow(nil, values...)
+	return Onpageshow(nil, values...)
+}
+
+func Onpageswap(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onpageswap"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onpageswap"}}onpageswap="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onpageswap"}}onpageswap="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Onpageswap_(values ...string) Attribute {
+	return Onpageswap(nil, values...)
+}
 
 func Onpaste(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onpaste" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onpaste"}}onpaste="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onpaste"}}onpaste="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onpaste"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onpaste"}}onpaste="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onpaste"}}onpaste="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onpaste_(values ...string) Attribute {
-    return Onpaste(nil, values...)
+	return Onpaste(nil, values...)
 }
 
-
 func Onpause(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onpause" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onpause"}}onpause="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onpause"}}onpause="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onpause"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onpause"}}onpause="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onpause"}}onpause="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onpause_(values ...string) Attribute {
-    return Onpause(nil, values...)
+	return Onpause(nil, values...)
 }
 
-
 func Onplay(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onplay" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onplay"}}onplay="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onplay"}}onplay="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onplay"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onplay"}}onplay="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onplay"}}onplay="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onplay_(values ...string) Attribute {
-    return Onplay(nil, values...)
+	return Onplay(nil, values...)
 }
 
-
 func Onplaying(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onplaying" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onplaying"}}onplaying="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onplaying"}}onplaying="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onplaying"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onplaying"}}onplaying="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onplaying"}}onplaying="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onplaying_(values ...string) Attribute {
-    return Onplaying(nil, values...)
+	return Onplaying(nil, values...)
 }
 
-
 func Onpopstate(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onpopstate" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onpopstate"}}onpopstate="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onpopstate"}}onpopstate="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onpopstate"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onpopstate"}}onpopstate="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onpopstate"}}onpopstate="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onpopstate_(values ...string) Attribute {
-    return Onpopstate(nil, values...)
+	return Onpopstate(nil, values...)
 }
 
-
 func Onprogress(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onprogress" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onprogress"}}onprogress="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onprogress"}}onprogress="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onprogress"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onprogress"}}onprogress="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onprogress"}}onprogress="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onprogress_(values ...string) Attribute {
-    return Onprogress(nil, values...)
+	return Onprogress(nil, values...)
 }
 
-
 func Onratechange(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onratechange" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onratechange"}}onratechange="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onratechange"}}onratechange="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onratechange"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onratechange"}}onratechange="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onratechange"}}onratechange="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onratechange_(values ...string) Attribute {
-    return Onratechange(nil, values...)
+	return Onratechange(nil, values...)
+}
+
+func Onrejectionhandled(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onrejectionhandled"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onrejectionhandled"}}onrejectionhandled="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onrejectionhandled"}}onrejectionhandled="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Onrejectionhandled_(values ...string) Attribute {
+	return Onrejectionhandled(nil, values...)
+}
 
 func Onreset(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onreset" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onreset"}}onreset="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onreset"}}onreset="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onreset"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onreset"}}onreset="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onreset"}}onreset="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onreset_(values ...string) Attribute {
-    return Onreset(nil, values...)
+	return Onreset(nil, values...)
 }
 
-
 func Onresize(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onresize" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onresize"}}onresize="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onresize"}}onresize="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onresize"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onresize"}}onresize="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onresize"}}onresize="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onresize_(values ...string) Attribute {
-    return Onresize(nil, values...)
+	return Onresize(nil, values...)
 }
 
-
 func Onscroll(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onscroll" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onscroll"}}onscroll="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onscroll"}}onscroll="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onscroll"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onscroll"}}onscroll="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onscroll"}}onscroll="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onscroll_(values ...string) Attribute {
-    return Onscroll(nil, values...)
+	return Onscroll(nil, values...)
 }
 
+func Onscrollend(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onscrollend"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onscrollend"}}onscrollend="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onscrollend"}}onscrollend="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Onsearch(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onsearch" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onsearch"}}onsearch="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onsearch"}}onsearch="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func Onscrollend_(values ...string) Attribute {
+	return Onscrollend(nil, values...)
 }
 
-func Onsearch_(values ...string) Attribute {
-    return Onsearch(nil, values...)
+func Onsecuritypolicyviolation(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onsecuritypolicyviolation"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onsecuritypolicyviolation"}}onsecuritypolicyviolation="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onsecuritypolicyviolation"}}onsecuritypolicyviolation="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func Onsecuritypolicyviolation_(values ...string) Attribute {
+	return Onsecuritypolicyviolation(nil, values...)
+}
 
 func Onseeked(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onseeked" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onseeked"}}onseeked="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onseeked"}}onseeked="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onseeked"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onseeked"}}onseeked="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onseeked"}}onseeked="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onseeked_(values ...string) Attribute {
-    return Onseeked(nil, values...)
+	return Onseeked(nil, values...)
 }
 
-
 func Onseeking(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onseeking" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onseeking"}}onseeking="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onseeking"}}onseeking="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onseeking"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onseeking"}}onseeking="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onseeking"}}onseeking="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onseeking_(values ...string) Attribute {
-    return Onseeking(nil, values...)
+	return Onseeking(nil, values...)
 }
 
-
 func Onselect(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onselect" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onselect"}}onselect="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onselect"}}onselect="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onselect"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onselect"}}onselect="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onselect"}}onselect="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onselect_(values ...string) Attribute {
-    return Onselect(nil, values...)
+	return Onselect(nil, values...)
 }
 
+func Onslotchange(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onslotchange"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onslotchange"}}onslotchange="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onslotchange"}}onslotchange="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Onslotchange_(values ...string) Attribute {
+	return Onslotchange(nil, values...)
+}
 
 func Onstalled(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onstalled" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onstalled"}}onstalled="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onstalled"}}onstalled="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onstalled"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onstalled"}}onstalled="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onstalled"}}onstalled="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onstalled_(values ...string) Attribute {
-    return Onstalled(nil, values...)
+	return Onstalled(nil, values...)
 }
 
-
 func Onstorage(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onstorage" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onstorage"}}onstorage="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onstorage"}}onstorage="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onstorage"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onstorage"}}onstorage="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onstorage"}}onstorage="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onstorage_(values ...string) Attribute {
-    return Onstorage(nil, values...)
+	return Onstorage(nil, values...)
 }
 
-
 func Onsubmit(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onsubmit" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onsubmit"}}onsubmit="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onsubmit"}}onsubmit="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onsubmit"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onsubmit"}}onsubmit="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onsubmit"}}onsubmit="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onsubmit_(values ...string) Attribute {
-    return Onsubmit(nil, values...)
+	return Onsubmit(nil, values...)
 }
 
-
 func Onsuspend(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onsuspend" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onsuspend"}}onsuspend="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onsuspend"}}onsuspend="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onsuspend"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onsuspend"}}onsuspend="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onsuspend"}}onsuspend="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onsuspend_(values ...string) Attribute {
-    return Onsuspend(nil, values...)
+	return Onsuspend(nil, values...)
 }
 
-
 func Ontimeupdate(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ontimeupdate" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ontimeupdate"}}ontimeupdate="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ontimeupdate"}}ontimeupdate="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ontimeupdate"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ontimeupdate"}}ontimeupdate="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ontimeupdate"}}ontimeupdate="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ontimeupdate_(values ...string) Attribute {
-    return Ontimeupdate(nil, values...)
+	return Ontimeupdate(nil, values...)
 }
 
-
 func Ontoggle(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Ontoggle" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Ontoggle"}}ontoggle="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Ontoggle"}}ontoggle="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Ontoggle"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ontoggle"}}ontoggle="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ontoggle"}}ontoggle="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Ontoggle_(values ...string) Attribute {
-    return Ontoggle(nil, values...)
+	return Ontoggle(nil, values...)
 }
 
+func Onunhandledrejection(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "Onunhandledrejection"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onunhandledrejection"}}onunhandledrejection="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onunhandledrejection"}}onunhandledrejection="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Onunhandledrejection_(values ...string) Attribute {
+	return Onunhandledrejection(nil, values...)
+}
 
 func Onunload(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onunload" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onunload"}}onunload="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onunload"}}onunload="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onunload"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onunload"}}onunload="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onunload"}}onunload="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onunload_(values ...string) Attribute {
-    return Onunload(nil, values...)
+	return Onunload(nil, values...)
 }
 
-
 func Onvolumechange(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onvolumechange" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onvolumechange"}}onvolumechange="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onvolumechange"}}onvolumechange="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onvolumechange"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onvolumechange"}}onvolumechange="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onvolumechange"}}onvolumechange="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onvolumechange_(values ...string) Attribute {
-    return Onvolumechange(nil, values...)
+	return Onvolumechange(nil, values...)
 }
 
-
 func Onwaiting(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onwaiting" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onwaiting"}}onwaiting="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onwaiting"}}onwaiting="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onwaiting"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onwaiting"}}onwaiting="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onwaiting"}}onwaiting="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onwaiting_(values ...string) Attribute {
-    return Onwaiting(nil, values...)
+	return Onwaiting(nil, values...)
 }
 
-
 func Onwheel(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Onwheel" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Onwheel"}}onwheel="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Onwheel"}}onwheel="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+	attr := Attribute{Data: data, Name: "Onwheel"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Onwheel"}}onwheel="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Onwheel"}}onwheel="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
 func Onwheel_(values ...string) Attribute {
-    return Onwheel(nil, values...)
+	return Onwheel(nil, values...)
 }
 
-
-func Open(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Open" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Open"}}open="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Open"}}open="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaActivedescendant(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaActivedescendant"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaActivedescendant"}}aria-activedescendant="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaActivedescendant"}}aria-activedescendant="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Open_(values ...string) Attribute {
-    return Open(nil, values...)
+func AriaActivedescendant_(values ...string) Attribute {
+	return AriaActivedescendant(nil, values...)
 }
 
-
-func Optimum(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Optimum" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Optimum"}}optimum="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Optimum"}}optimum="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaAtomic(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaAtomic"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaAtomic"}}aria-atomic="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaAtomic"}}aria-atomic="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Optimum_(values ...string) Attribute {
-    return Optimum(nil, values...)
+func AriaAtomic_(values ...string) Attribute {
+	return AriaAtomic(nil, values...)
 }
 
-
-func Pattern(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Pattern" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Pattern"}}pattern="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Pattern"}}pattern="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaAutocomplete(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaAutocomplete"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaAutocomplete"}}aria-autocomplete="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaAutocomplete"}}aria-autocomplete="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Pattern_(values ...string) Attribute {
-    return Pattern(nil, values...)
+func AriaAutocomplete_(values ...string) Attribute {
+	return AriaAutocomplete(nil, values...)
 }
 
-
-func Placeholder(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Placeholder" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Placeholder"}}placeholder="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Placeholder"}}placeholder="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaBusy(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaBusy"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaBusy"}}aria-busy="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaBusy"}}aria-busy="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Placeholder_(values ...string) Attribute {
-    return Placeholder(nil, values...)
+func AriaBusy_(values ...string) Attribute {
+	return AriaBusy(nil, values...)
 }
 
-
-func Poster(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Poster" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Poster"}}poster="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Poster"}}poster="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaChecked(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaChecked"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaChecked"}}aria-checked="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaChecked"}}aria-checked="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Poster_(values ...string) Attribute {
-    return Poster(nil, values...)
+func AriaChecked_(values ...string) Attribute {
+	return AriaChecked(nil, values...)
 }
 
-
-func Preload(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Preload" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Preload"}}preload="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Preload"}}preload="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaColcount(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaColcount"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaColcount"}}aria-colcount="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaColcount"}}aria-colcount="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Preload_(values ...string) Attribute {
-    return Preload(nil, values...)
+func AriaColcount_(values ...string) Attribute {
+	return AriaColcount(nil, values...)
 }
 
-
-func Readonly(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Readonly" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Readonly"}}readonly="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Readonly"}}readonly="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaColindex(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaColindex"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaColindex"}}aria-colindex="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaColindex"}}aria-colindex="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Readonly_(values ...string) Attribute {
-    return Readonly(nil, values...)
+func AriaColindex_(values ...string) Attribute {
+	return AriaColindex(nil, values...)
 }
 
-
-func Rel(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Rel" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Rel"}}rel="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Rel"}}rel="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaColspan(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaColspan"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaColspan"}}aria-colspan="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaColspan"}}aria-colspan="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Rel_(values ...string) Attribute {
-    return Rel(nil, values...)
+func AriaColspan_(values ...string) Attribute {
+	return AriaColspan(nil, values...)
 }
 
-
-func Required(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Required" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Required"}}required="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Required"}}required="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaControls(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaControls"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaControls"}}aria-controls="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaControls"}}aria-controls="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Required_(values ...string) Attribute {
-    return Required(nil, values...)
+func AriaControls_(values ...string) Attribute {
+	return AriaControls(nil, values...)
 }
 
-
-func Reversed(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Reversed" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Reversed"}}reversed="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Reversed"}}reversed="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaCurrent(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaCurrent"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaCurrent"}}aria-current="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaCurrent"}}aria-current="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Reversed_(values ...string) Attribute {
-    return Reversed(nil, values...)
+func AriaCurrent_(values ...string) Attribute {
+	return AriaCurrent(nil, values...)
 }
 
-
-func Role(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Role" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Role"}}role="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Role"}}role="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaDescribedby(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaDescribedby"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaDescribedby"}}aria-describedby="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaDescribedby"}}aria-describedby="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Role_(values ...string) Attribute {
-    return Role(nil, values...)
+func AriaDescribedby_(values ...string) Attribute {
+	return AriaDescribedby(nil, values...)
 }
 
-
-func Rows(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Rows" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Rows"}}rows="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Rows"}}rows="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaDetails(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaDetails"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaDetails"}}aria-details="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaDetails"}}aria-details="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Rows_(values ...string) Attribute {
-    return Rows(nil, values...)
+func AriaDetails_(values ...string) Attribute {
+	return AriaDetails(nil, values...)
 }
 
-
-func Rowspan(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Rowspan" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Rowspan"}}rowspan="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Rowspan"}}rowspan="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaDisabled(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaDisabled"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaDisabled"}}aria-disabled="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaDisabled"}}aria-disabled="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Rowspan_(values ...string) Attribute {
-    return Rowspan(nil, values...)
+func AriaDisabled_(values ...string) Attribute {
+	return AriaDisabled(nil, values...)
 }
 
-
-func Sandbox(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Sandbox" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Sandbox"}}sandbox="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Sandbox"}}sandbox="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaDropeffect(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaDropeffect"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaDropeffect"}}aria-dropeffect="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaDropeffect"}}aria-dropeffect="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Sandbox_(values ...string) Attribute {
-    return Sandbox(nil, values...)
+func AriaDropeffect_(values ...string) Attribute {
+	return AriaDropeffect(nil, values...)
 }
 
-
-func Scope(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Scope" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Scope"}}scope="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Scope"}}scope="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaErrormessage(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaErrormessage"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaErrormessage"}}aria-errormessage="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaErrormessage"}}aria-errormessage="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Scope_(values ...string) Attribute {
-    return Scope(nil, values...)
+func AriaErrormessage_(values ...string) Attribute {
+	return AriaErrormessage(nil, values...)
 }
 
-
-func Selected(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Selected" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Selected"}}selected="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Selected"}}selected="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaExpanded(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaExpanded"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaExpanded"}}aria-expanded="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaExpanded"}}aria-expanded="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Selected_(values ...string) Attribute {
-    return Selected(nil, values...)
+func AriaExpanded_(values ...string) Attribute {
+	return AriaExpanded(nil, values...)
 }
 
+func AriaFlowto(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaFlowto"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaFlowto"}}aria-flowto="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaFlowto"}}aria-flowto="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Shape(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Shape" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Shape"}}shape="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Shape"}}shape="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaFlowto_(values ...string) Attribute {
+	return AriaFlowto(nil, values...)
 }
 
-func Shape_(values ...string) Attribute {
-    return Shape(nil, values...)
+func AriaGrabbed(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaGrabbed"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaGrabbed"}}aria-grabbed="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaGrabbed"}}aria-grabbed="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func AriaGrabbed_(values ...string) Attribute {
+	return AriaGrabbed(nil, values...)
+}
 
-func Size(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Size" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Size"}}size="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Size"}}size="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaHaspopup(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaHaspopup"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaHaspopup"}}aria-haspopup="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaHaspopup"}}aria-haspopup="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Size_(values ...string) Attribute {
-    return Size(nil, values...)
+func AriaHaspopup_(values ...string) Attribute {
+	return AriaHaspopup(nil, values...)
 }
 
+func AriaHidden(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaHidden"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaHidden"}}aria-hidden="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaHidden"}}aria-hidden="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Sizes(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Sizes" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Sizes"}}sizes="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Sizes"}}sizes="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaHidden_(values ...string) Attribute {
+	return AriaHidden(nil, values...)
 }
 
-func Sizes_(values ...string) Attribute {
-    return Sizes(nil, values...)
+func AriaInvalid(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaInvalid"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaInvalid"}}aria-invalid="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaInvalid"}}aria-invalid="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func AriaInvalid_(values ...string) Attribute {
+	return AriaInvalid(nil, values...)
+}
 
-func Span(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Span" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Span"}}span="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Span"}}span="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaKeyshortcuts(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaKeyshortcuts"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaKeyshortcuts"}}aria-keyshortcuts="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaKeyshortcuts"}}aria-keyshortcuts="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Span_(values ...string) Attribute {
-    return Span(nil, values...)
+func AriaKeyshortcuts_(values ...string) Attribute {
+	return AriaKeyshortcuts(nil, values...)
 }
 
+func AriaLabel(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaLabel"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaLabel"}}aria-label="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaLabel"}}aria-label="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Spellcheck(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Spellcheck" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Spellcheck"}}spellcheck="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Spellcheck"}}spellcheck="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaLabel_(values ...string) Attribute {
+	return AriaLabel(nil, values...)
 }
 
-func Spellcheck_(values ...string) Attribute {
-    return Spellcheck(nil, values...)
+func AriaLabelledby(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaLabelledby"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaLabelledby"}}aria-labelledby="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaLabelledby"}}aria-labelledby="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func AriaLabelledby_(values ...string) Attribute {
+	return AriaLabelledby(nil, values...)
+}
 
-func Src(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Src" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Src"}}src="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Src"}}src="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaLevel(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaLevel"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaLevel"}}aria-level="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaLevel"}}aria-level="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Src_(values ...string) Attribute {
-    return Src(nil, values...)
+func AriaLevel_(values ...string) Attribute {
+	return AriaLevel(nil, values...)
 }
 
+func AriaLive(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaLive"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaLive"}}aria-live="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaLive"}}aria-live="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Srcdoc(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Srcdoc" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Srcdoc"}}srcdoc="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Srcdoc"}}srcdoc="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaLive_(values ...string) Attribute {
+	return AriaLive(nil, values...)
 }
 
-func Srcdoc_(values ...string) Attribute {
-    return Srcdoc(nil, values...)
+func AriaModal(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaModal"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaModal"}}aria-modal="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaModal"}}aria-modal="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func AriaModal_(values ...string) Attribute {
+	return AriaModal(nil, values...)
+}
 
-func Srclang(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Srclang" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Srclang"}}srclang="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Srclang"}}srclang="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaMultiline(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaMultiline"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaMultiline"}}aria-multiline="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaMultiline"}}aria-multiline="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Srclang_(values ...string) Attribute {
-    return Srclang(nil, values...)
+func AriaMultiline_(values ...string) Attribute {
+	return AriaMultiline(nil, values...)
 }
 
+func AriaMultiselectable(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaMultiselectable"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaMultiselectable"}}aria-multiselectable="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaMultiselectable"}}aria-multiselectable="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Srcset(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Srcset" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Srcset"}}srcset="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Srcset"}}srcset="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaMultiselectable_(values ...string) Attribute {
+	return AriaMultiselectable(nil, values...)
 }
 
-func Srcset_(values ...string) Attribute {
-    return Srcset(nil, values...)
+func AriaOrientation(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaOrientation"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaOrientation"}}aria-orientation="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaOrientation"}}aria-orientation="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func AriaOrientation_(values ...string) Attribute {
+	return AriaOrientation(nil, values...)
+}
 
-func Start(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Start" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Start"}}start="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Start"}}start="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaOwns(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaOwns"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaOwns"}}aria-owns="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaOwns"}}aria-owns="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Start_(values ...string) Attribute {
-    return Start(nil, values...)
+func AriaOwns_(values ...string) Attribute {
+	return AriaOwns(nil, values...)
 }
 
+func AriaPlaceholder(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaPlaceholder"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaPlaceholder"}}aria-placeholder="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaPlaceholder"}}aria-placeholder="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Step(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Step" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Step"}}step="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Step"}}step="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaPlaceholder_(values ...string) Attribute {
+	return AriaPlaceholder(nil, values...)
 }
 
-func Step_(values ...string) Attribute {
-    return Step(nil, values...)
+func AriaPosinset(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaPosinset"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaPosinset"}}aria-posinset="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaPosinset"}}aria-posinset="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func AriaPosinset_(values ...string) Attribute {
+	return AriaPosinset(nil, values...)
+}
 
-func Style(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Style" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Style"}}style="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Style"}}style="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaPressed(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaPressed"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaPressed"}}aria-pressed="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaPressed"}}aria-pressed="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Style_(values ...string) Attribute {
-    return Style(nil, values...)
+func AriaPressed_(values ...string) Attribute {
+	return AriaPressed(nil, values...)
 }
 
+func AriaReadonly(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaReadonly"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaReadonly"}}aria-readonly="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaReadonly"}}aria-readonly="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Tabindex(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Tabindex" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Tabindex"}}tabindex="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Tabindex"}}tabindex="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaReadonly_(values ...string) Attribute {
+	return AriaReadonly(nil, values...)
 }
 
-func Tabindex_(values ...string) Attribute {
-    return Tabindex(nil, values...)
+func AriaRelevant(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaRelevant"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaRelevant"}}aria-relevant="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaRelevant"}}aria-relevant="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func AriaRelevant_(values ...string) Attribute {
+	return AriaRelevant(nil, values...)
+}
 
-func Target(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Target" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Target"}}target="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Target"}}target="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaRequired(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaRequired"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaRequired"}}aria-required="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaRequired"}}aria-required="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Target_(values ...string) Attribute {
-    return Target(nil, values...)
+func AriaRequired_(values ...string) Attribute {
+	return AriaRequired(nil, values...)
 }
 
+func AriaRoledescription(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaRoledescription"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaRoledescription"}}aria-roledescription="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaRoledescription"}}aria-roledescription="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Title(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Title" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Title"}}title="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Title"}}title="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaRoledescription_(values ...string) Attribute {
+	return AriaRoledescription(nil, values...)
 }
 
-func Title_(values ...string) Attribute {
-    return Title(nil, values...)
+func AriaRowcount(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaRowcount"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaRowcount"}}aria-rowcount="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaRowcount"}}aria-rowcount="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func AriaRowcount_(values ...string) Attribute {
+	return AriaRowcount(nil, values...)
+}
 
-func Translate(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Translate" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Translate"}}translate="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Translate"}}translate="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaRowindex(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaRowindex"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaRowindex"}}aria-rowindex="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaRowindex"}}aria-rowindex="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Translate_(values ...string) Attribute {
-    return Translate(nil, values...)
+func AriaRowindex_(values ...string) Attribute {
+	return AriaRowindex(nil, values...)
 }
 
+func AriaRowspan(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaRowspan"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaRowspan"}}aria-rowspan="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaRowspan"}}aria-rowspan="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Type(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Type" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Type"}}type="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Type"}}type="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaRowspan_(values ...string) Attribute {
+	return AriaRowspan(nil, values...)
 }
 
-func Type_(values ...string) Attribute {
-    return Type(nil, values...)
+func AriaSelected(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaSelected"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaSelected"}}aria-selected="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaSelected"}}aria-selected="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func AriaSelected_(values ...string) Attribute {
+	return AriaSelected(nil, values...)
+}
 
-func Usemap(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Usemap" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Usemap"}}usemap="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Usemap"}}usemap="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaSetsize(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaSetsize"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaSetsize"}}aria-setsize="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaSetsize"}}aria-setsize="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Usemap_(values ...string) Attribute {
-    return Usemap(nil, values...)
+func AriaSetsize_(values ...string) Attribute {
+	return AriaSetsize(nil, values...)
 }
 
+func AriaSort(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaSort"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaSort"}}aria-sort="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaSort"}}aria-sort="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Value(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Value" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Value"}}value="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Value"}}value="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaSort_(values ...string) Attribute {
+	return AriaSort(nil, values...)
 }
 
-func Value_(values ...string) Attribute {
-    return Value(nil, values...)
+func AriaValuemax(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaValuemax"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaValuemax"}}aria-valuemax="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaValuemax"}}aria-valuemax="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func AriaValuemax_(values ...string) Attribute {
+	return AriaValuemax(nil, values...)
+}
 
-func Width(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Width" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Width"}}width="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Width"}}width="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaValuemin(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaValuemin"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaValuemin"}}aria-valuemin="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaValuemin"}}aria-valuemin="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
-func Width_(values ...string) Attribute {
-    return Width(nil, values...)
+func AriaValuemin_(values ...string) Attribute {
+	return AriaValuemin(nil, values...)
 }
 
+func AriaValuenow(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaValuenow"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaValuenow"}}aria-valuenow="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaValuenow"}}aria-valuenow="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
 
-func Wrap(data interface{}, templs ...string) Attribute {
-    attr := Attribute{ Data: data, Name: "Wrap" }
-    if len(templs) == 0 {
-        attr.Templ = `{{define "Wrap"}}wrap="{{.}}"{{end}}`
-    } else {
-        attr.Templ = `{{define "Wrap"}}wrap="` + strings.Join(templs, " ") + `"{{end}}`
-    }
-    return attr
+func AriaValuenow_(values ...string) Attribute {
+	return AriaValuenow(nil, values...)
 }
 
-func Wrap_(values ...string) Attribute {
-    return Wrap(nil, values...)
+func AriaValuetext(data interface{}, templs ...string) Attribute {
+	attr := Attribute{Data: data, Name: "AriaValuetext"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AriaValuetext"}}aria-valuetext="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AriaValuetext"}}aria-valuetext="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
 }
 
+func AriaValuetext_(values ...string) Attribute {
+	return AriaValuetext(nil, values...)
+}
@@ -0,0 +1,89 @@
+package attributes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ClassCond is a set of class tokens which is only used if Enabled is true
+type ClassCond struct {
+	Classes []string
+	Enabled bool
+}
+
+// ClassIf adds the class tokens to Classes only if cond is true
+func ClassIf(cond bool, classes ...string) ClassCond {
+	return ClassCond{Classes: classes, Enabled: cond}
+}
+
+// Classes builds a class attribute from class tokens, which can be passed as
+//   - string, containing one or more space-separated tokens
+//   - []string
+//   - map[string]bool, using the keys whose value is true in sorted order
+//   - ClassCond, as returned by ClassIf
+//
+// Any other value is formatted with fmt.Sprint. Only the last of repeated
+// tokens is kept, and each token is escaped separately. When an element has
+// several class attributes, e.g. from Class_ and Classes, their tokens are
+// merged into a single class attribute.
+//
+//	Classes("btn", ClassIf(primary, "btn-primary"), map[string]bool{"active": active})
+func Classes(items ...interface{}) Attribute {
+	tokens := []string{}
+	for _, item := range items {
+		tokens = append(tokens, classTokens(item)...)
+	}
+
+	return Attribute{
+		Data:  mergeClasses(tokens, nil),
+		Templ: `{{define "Class"}}class="{{range $i, $c := .}}{{if $i}} {{end}}{{$c}}{{end}}"{{end}}`,
+		Name:  "Class",
+	}
+}
+
+func classTokens(item interface{}) []string {
+	switch v := item.(type) {
+	case nil:
+		return nil
+	case string:
+		return strings.Fields(v)
+	case []string:
+		return strings.Fields(strings.Join(v, " "))
+	case map[string]bool:
+		keys := []string{}
+		for k, enabled := range v {
+			if enabled {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		return strings.Fields(strings.Join(keys, " "))
+	case ClassCond:
+		if !v.Enabled {
+			return nil
+		}
+		return strings.Fields(strings.Join(v.Classes, " "))
+	default:
+		return strings.Fields(fmt.Sprint(v))
+	}
+}
+
+// mergeClasses removes repeated tokens, keeping the last occurrence, so that
+// a later token takes precedence like for a merger, and applies the merger
+// unless it is nil
+func mergeClasses(tokens []string, merger ClassMerger) []string {
+	seen := map[string]struct{}{}
+	merged := []string{}
+	for i := len(tokens) - 1; i >= 0; i-- {
+		if _, ok := seen[tokens[i]]; ok {
+			continue
+		}
+		seen[tokens[i]] = struct{}{}
+		merged = append([]string{tokens[i]}, merged...)
+	}
+	if merger != nil {
+		merged = merger.MergeClasses(merged)
+	}
+	return merged
+}
@@ -0,0 +1,100 @@
+package attributes
+
+import (
+	"strings"
+)
+
+// The attributes below were removed from the generated functions, as they
+// are not part of the HTML Living Standard. They are kept for a release, so
+// that callers can migrate.
+
+// Deprecated: Align is obsolete, use the attributes package of
+// htmlgo/obsolete or CSS instead.
+func Align(data interface{}, templs ...string) Attribute {
+	return deprecated("Align", "align", data, templs)
+}
+
+// Deprecated: Align_ is obsolete, use the attributes package of
+// htmlgo/obsolete or CSS instead.
+func Align_(values ...string) Attribute {
+	return Align(nil, values...)
+}
+
+// Deprecated: Bgcolor is obsolete, use the attributes package of
+// htmlgo/obsolete or CSS instead.
+func Bgcolor(data interface{}, templs ...string) Attribute {
+	return deprecated("Bgcolor", "bgcolor", data, templs)
+}
+
+// Deprecated: Bgcolor_ is obsolete, use the attributes package of
+// htmlgo/obsolete or CSS instead.
+func Bgcolor_(values ...string) Attribute {
+	return Bgcolor(nil, values...)
+}
+
+// Deprecated: Border is obsolete, use the attributes package of
+// htmlgo/obsolete or CSS instead.
+func Border(data interface{}, templs ...string) Attribute {
+	return deprecated("Border", "border", data, templs)
+}
+
+// Deprecated: Border_ is obsolete, use the attributes package of
+// htmlgo/obsolete or CSS instead.
+func Border_(values ...string) Attribute {
+	return Border(nil, values...)
+}
+
+// Deprecated: Dropzone was removed from HTML and is not supported by
+// browsers.
+func Dropzone(data interface{}, templs ...string) Attribute {
+	return deprecated("Dropzone", "dropzone", data, templs)
+}
+
+// Deprecated: Dropzone_ was removed from HTML and is not supported by
+// browsers.
+func Dropzone_(values ...string) Attribute {
+	return Dropzone(nil, values...)
+}
+
+// Deprecated: InitialScale is not an attribute, but a property of the
+// content of the viewport meta element.
+func InitialScale(data interface{}, templs ...string) Attribute {
+	return deprecated("InitialScale", "initial-scale", data, templs)
+}
+
+// Deprecated: InitialScale_ is not an attribute, but a property of the
+// content of the viewport meta element.
+func InitialScale_(values ...string) Attribute {
+	return InitialScale(nil, values...)
+}
+
+// Deprecated: Onmousewheel is non-standard, use Onwheel instead.
+func Onmousewheel(data interface{}, templs ...string) Attribute {
+	return deprecated("Onmousewheel", "onmousewheel", data, templs)
+}
+
+// Deprecated: Onmousewheel_ is non-standard, use Onwheel_ instead.
+func Onmousewheel_(values ...string) Attribute {
+	return Onmousewheel(nil, values...)
+}
+
+// Deprecated: Onsearch is non-standard, use Oninput instead.
+func Onsearch(data interface{}, templs ...string) Attribute {
+	return deprecated("Onsearch", "onsearch", data, templs)
+}
+
+// Deprecated: Onsearch_ is non-standard, use Oninput_ instead.
+func Onsearch_(values ...string) Attribute {
+	return Onsearch(nil, values...)
+}
+
+// deprecated builds an attribute like the generated functions
+func deprecated(name, attrName string, data interface{}, templs []string) Attribute {
+	attr := Attribute{Data: data, Name: name}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "` + name + `"}}` + attrName + `="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "` + name + `"}}` + attrName + `="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
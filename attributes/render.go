@@ -0,0 +1,190 @@
+package attributes
+
+import (
+	"bytes"
+	"html/template"
+	"strings"
+)
+
+// Rendered is an attribute after executing its template. Value is escaped
+// for use within a double-quoted attribute value.
+type Rendered struct {
+	Name     string
+	Value    string
+	HasValue bool
+}
+
+func (r Rendered) String() string {
+	if !r.HasValue {
+		return r.Name
+	}
+	return r.Name + `="` + r.Value + `"`
+}
+
+// Render executes the templates of attrs with their data and returns the
+// resulting attributes in order. Each template is executed inside a start
+// tag, so html/template applies the escaping of the attribute's context,
+// e.g. URL filtering for href. Attributes whose template fails are dropped.
+// Repeated class and style attributes are merged into the first one, using
+// the ClassMerger passed with MergeClasses.
+func Render(attrs []Attribute) []Rendered {
+	rendered := []Rendered{}
+	var merger ClassMerger
+	for _, attr := range attrs {
+		if m, ok := attr.Data.(ClassMerger); ok && attr.Name == classMergerName {
+			merger = m
+			continue
+		}
+		rendered = append(rendered, render(attr)...)
+	}
+	return merge(rendered, merger)
+}
+
+func render(attr Attribute) []Rendered {
+	t, err := template.New("_").Parse(attr.Templ + `<x {{template "` + attr.Name + `" .}}>`)
+	if err != nil {
+		return nil
+	}
+	buf := new(bytes.Buffer)
+	if err := t.Execute(buf, attr.Data); err != nil {
+		return nil
+	}
+	s := strings.TrimSuffix(strings.TrimPrefix(buf.String(), "<x "), ">")
+	return parse(s)
+}
+
+// parse splits the output of an executed attribute template into attributes
+func parse(s string) []Rendered {
+	rendered := []Rendered{}
+	for {
+		s = strings.TrimLeft(s, " \t\n\f\r")
+		if s == "" {
+			return rendered
+		}
+
+		end := strings.IndexAny(s, " \t\n\f\r=")
+		if end < 0 {
+			end = len(s)
+		}
+		r := Rendered{Name: s[:end]}
+		s = s[end:]
+
+		if strings.HasPrefix(s, "=") {
+			r.HasValue = true
+			s = s[1:]
+			if s != "" && (s[0] == '"' || s[0] == '\'') {
+				quote := s[0]
+				s = s[1:]
+				end = strings.IndexByte(s, quote)
+				if end < 0 {
+					r.Value, s = s, ""
+				} else {
+					r.Value, s = s[:end], s[end+1:]
+				}
+			} else {
+				end = strings.IndexAny(s, " \t\n\f\r")
+				if end < 0 {
+					end = len(s)
+				}
+				r.Value = s[:end]
+				s = s[end:]
+			}
+			r.Value = strings.ReplaceAll(r.Value, `"`, "&#34;")
+		}
+
+		if r.Name != "" {
+			rendered = append(rendered, r)
+		}
+	}
+}
+
+// merge combines repeated attributes where their values can be combined:
+// the tokens of class attributes are merged, and the declarations of style
+// attributes are concatenated, with later declarations of a property
+// replacing earlier ones
+func merge(rendered []Rendered, merger ClassMerger) []Rendered {
+	merged := []Rendered{}
+	first := map[string]int{}
+	for _, r := range rendered {
+		name := strings.ToLower(r.Name)
+		i, seen := first[name]
+		switch {
+		case name == "class" && seen:
+			merged[i].Value += " " + r.Value
+		case name == "style" && seen:
+			merged[i].Value += ";" + r.Value
+		default:
+			if !seen {
+				first[name] = len(merged)
+			}
+			merged = append(merged, r)
+		}
+	}
+	if i, ok := first["class"]; ok {
+		merged[i].Value = strings.Join(mergeClasses(strings.Fields(merged[i].Value), merger), " ")
+		merged[i].HasValue = true
+	}
+	if i, ok := first["style"]; ok {
+		merged[i].Value = mergeDeclarations(merged[i].Value)
+		merged[i].HasValue = true
+	}
+	return merged
+}
+
+// mergeDeclarations removes empty declarations and all but the last
+// declaration of each property from an escaped style attribute value
+func mergeDeclarations(style string) string {
+	decls := splitDeclarations(style)
+	last := map[string]int{}
+	for i, d := range decls {
+		property, _, _ := strings.Cut(d, ":")
+		last[strings.ToLower(strings.TrimSpace(property))] = i
+	}
+
+	kept := []string{}
+	for i, d := range decls {
+		property, _, _ := strings.Cut(d, ":")
+		if strings.TrimSpace(d) == "" || last[strings.ToLower(strings.TrimSpace(property))] != i {
+			continue
+		}
+		kept = append(kept, strings.TrimSpace(d))
+	}
+	return strings.Join(kept, ";")
+}
+
+// splitDeclarations splits an escaped style attribute value at semicolons
+// which are neither part of a character reference nor within parentheses
+func splitDeclarations(style string) []string {
+	decls := []string{}
+	depth, start := 0, 0
+	for i := 0; i < len(style); i++ {
+		switch style[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '&':
+			if end := strings.IndexByte(style[i:], ';'); end > 0 && isReference(style[i+1:i+end]) {
+				i += end
+			}
+		case ';':
+			if depth <= 0 {
+				decls = append(decls, style[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(decls, style[start:])
+}
+
+func isReference(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range strings.TrimPrefix(s, "#") {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
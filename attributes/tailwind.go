@@ -0,0 +1,383 @@
+package attributes
+
+import (
+	"sort"
+	"strings"
+)
+
+// ClassMerger resolves conflicts between class tokens. MergeClasses receives
+// the tokens of an element in order, without repetitions, and returns the
+// tokens to keep.
+type ClassMerger interface {
+	MergeClasses(tokens []string) []string
+}
+
+// MergeClasses applies merger to the class tokens of the element it is
+// passed to, after their class attributes are combined. Without it, only
+// repeated tokens are removed.
+//
+//	var tw = &attributes.TailwindMerger{Prefix: "tw-"}
+//
+//	Div(Attr(a.Class_("tw-p-2"), a.Class(extra), a.MergeClasses(tw)))
+func MergeClasses(merger ClassMerger) Attribute {
+	return Attribute{Data: merger, Name: classMergerName}
+}
+
+// classMergerName is the name of the attribute returned by MergeClasses,
+// which renders nothing itself
+const classMergerName = "ClassMerger"
+
+// TailwindMerger is a ClassMerger which understands the utility classes of
+// Tailwind CSS. Of several utilities which set the same property, only the
+// last one is kept, so that p-4 wins over an earlier p-2, and p-2 wins over
+// an earlier px-4, but an earlier p-2 is refined by a later px-4. Variants
+// such as hover: or md: and the important modifier ! are taken into
+// account, so that hover:p-2 and p-4 do not conflict. Tokens which are not
+// recognised as Tailwind utilities are always kept.
+type TailwindMerger struct {
+	// Prefix is the prefix configured for Tailwind, e.g. "tw-". Tokens
+	// without the prefix are not treated as utilities.
+	Prefix string
+	// Groups maps the names of custom utilities or utility prefixes to the
+	// group of utilities they conflict with, e.g. "text-body" to
+	// "font-size". They take precedence over the built-in groups.
+	Groups map[string]string
+}
+
+func (m *TailwindMerger) MergeClasses(tokens []string) []string {
+	taken := map[string]struct{}{}
+	keep := make([]bool, len(tokens))
+
+	for i := len(tokens) - 1; i >= 0; i-- {
+		variants, group := m.parse(tokens[i])
+		if group == "" {
+			keep[i] = true
+			continue
+		}
+		if _, ok := taken[variants+group]; ok {
+			continue
+		}
+		keep[i] = true
+		taken[variants+group] = struct{}{}
+		for _, g := range tailwindConflicts[group] {
+			taken[variants+g] = struct{}{}
+		}
+	}
+
+	merged := []string{}
+	for i, t := range tokens {
+		if keep[i] {
+			merged = append(merged, t)
+		}
+	}
+	return merged
+}
+
+// parse splits a token into a key of its variants and modifiers and the
+// group of its utility, which is empty for unknown tokens
+func (m *TailwindMerger) parse(token string) (string, string) {
+	parts := splitOutsideBrackets(token, ':')
+	utility := parts[len(parts)-1]
+	variants := parts[:len(parts)-1]
+	sort.Strings(variants)
+
+	important := ""
+	if strings.HasPrefix(utility, "!") || strings.HasSuffix(utility, "!") {
+		important = "!"
+		utility = strings.TrimSuffix(strings.TrimPrefix(utility, "!"), "!")
+	}
+	utility = strings.TrimPrefix(utility, "-")
+	if !strings.HasPrefix(utility, m.Prefix) {
+		return "", ""
+	}
+	utility = strings.TrimPrefix(utility, m.Prefix)
+	utility = strings.TrimPrefix(utility, "-")
+
+	// drop opacity and line-height modifiers such as bg-red-500/50
+	if slash := splitOutsideBrackets(utility, '/'); len(slash) > 1 {
+		utility = slash[0]
+	}
+
+	group := m.group(utility)
+	if group == "" {
+		return "", ""
+	}
+	return strings.Join(variants, ":") + ":" + important, group
+}
+
+func (m *TailwindMerger) group(utility string) string {
+	if g, ok := m.Groups[utility]; ok {
+		return g
+	}
+	if g, ok := tailwindExact[utility]; ok {
+		return g
+	}
+
+	// Try the longest prefix first, so that e.g. min-w-4 is not treated as
+	// an unknown min- utility. Arbitrary values are never split.
+	name, arbitrary := utility, ""
+	if i := strings.Index(utility, "-["); i >= 0 {
+		name, arbitrary = utility[:i], utility[i+1:]
+	}
+	parts := strings.Split(name, "-")
+	for i := len(parts); i >= 1; i-- {
+		prefix := strings.Join(parts[:i], "-")
+		value := strings.Join(parts[i:], "-")
+		if arbitrary != "" {
+			if value != "" {
+				value += "-"
+			}
+			value += arbitrary
+		} else if value == "" {
+			continue
+		}
+		if g, ok := m.Groups[prefix]; ok {
+			return g
+		}
+		if classify, ok := tailwindPrefixes[prefix]; ok {
+			return classify(value)
+		}
+	}
+	return ""
+}
+
+// splitOutsideBrackets splits s at sep, except within [...] and (...)
+func splitOutsideBrackets(s string, sep byte) []string {
+	parts := []string{}
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+		case sep:
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+func always(group string) func(string) string {
+	return func(string) string { return group }
+}
+
+// oneOf returns group if the value is one of values, and fallback otherwise
+func oneOf(group string, values string, fallback func(string) string) func(string) string {
+	set := map[string]struct{}{}
+	for _, v := range strings.Fields(values) {
+		set[v] = struct{}{}
+	}
+	return func(value string) string {
+		if _, ok := set[value]; ok {
+			return group
+		}
+		return fallback(value)
+	}
+}
+
+// isLength reports whether a value looks like a length rather than a
+// color, e.g. 2, 0.5, px, or an arbitrary value such as [3px]
+func isLength(value string) bool {
+	if strings.HasPrefix(value, "[") {
+		v := strings.TrimPrefix(strings.TrimSuffix(value, "]"), "[")
+		if strings.HasPrefix(v, "length:") {
+			return true
+		}
+		return v != "" && (v[0] >= '0' && v[0] <= '9' || v[0] == '.' || strings.HasPrefix(v, "calc("))
+	}
+	return value == "px" || value != "" && (value[0] >= '0' && value[0] <= '9')
+}
+
+func lengthOr(group, fallback string) func(string) string {
+	return func(value string) string {
+		if isLength(value) {
+			return group
+		}
+		return fallback
+	}
+}
+
+var tailwindExact = map[string]string{}
+
+func init() {
+	for group, utilities := range map[string]string{
+		"display": "block inline-block inline flex inline-flex table inline-table table-caption table-cell " +
+			"table-column table-column-group table-footer-group table-header-group table-row-group " +
+			"table-row flow-root grid inline-grid contents list-item hidden",
+		"position":        "static fixed absolute relative sticky",
+		"visibility":      "visible invisible collapse",
+		"font-style":      "italic not-italic",
+		"text-decoration": "underline overline line-through no-underline",
+		"text-transform":  "uppercase lowercase capitalize normal-case",
+		"font-smoothing":  "antialiased subpixel-antialiased",
+		"isolation":       "isolate isolation-auto",
+		"box-sizing":      "box-border box-content",
+		"sr":              "sr-only not-sr-only",
+		"truncate":        "truncate",
+		"shadow":          "shadow",
+		"rounded":         "rounded",
+		"border-w":        "border",
+		"ring-w":          "ring",
+		"grow":            "grow",
+		"shrink":          "shrink",
+		"transition":      "transition",
+		"outline-style":   "outline outline-none",
+	} {
+		for _, u := range strings.Fields(utilities) {
+			tailwindExact[u] = group
+		}
+	}
+}
+
+var tailwindPrefixes = map[string]func(string) string{
+	"p": always("p"), "px": always("px"), "py": always("py"),
+	"pt": always("pt"), "pr": always("pr"), "pb": always("pb"), "pl": always("pl"),
+	"ps": always("ps"), "pe": always("pe"),
+	"m": always("m"), "mx": always("mx"), "my": always("my"),
+	"mt": always("mt"), "mr": always("mr"), "mb": always("mb"), "ml": always("ml"),
+	"ms": always("ms"), "me": always("me"),
+	"space-x": always("space-x"), "space-y": always("space-y"),
+	"w": always("w"), "min-w": always("min-w"), "max-w": always("max-w"),
+	"h": always("h"), "min-h": always("min-h"), "max-h": always("max-h"),
+	"size":  always("size"),
+	"inset": always("inset"), "inset-x": always("inset-x"), "inset-y": always("inset-y"),
+	"top": always("top"), "right": always("right"), "bottom": always("bottom"), "left": always("left"),
+	"start": always("start"), "end": always("end"),
+	"z": always("z"), "order": always("order"), "opacity": always("opacity"),
+	"gap": always("gap"), "gap-x": always("gap-x"), "gap-y": always("gap-y"),
+	"basis": always("basis"),
+	"flex": oneOf("flex-direction", "row row-reverse col col-reverse",
+		oneOf("flex-wrap", "wrap wrap-reverse nowrap", always("flex"))),
+	"grow": always("grow"), "shrink": always("shrink"),
+	"justify":       always("justify-content"),
+	"justify-items": always("justify-items"), "justify-self": always("justify-self"),
+	"items":         always("align-items"),
+	"content":       oneOf("align-content", "normal center start end between around evenly baseline stretch", always("content")),
+	"self":          always("align-self"),
+	"place-content": always("place-content"), "place-items": always("place-items"),
+	"place-self": always("place-self"),
+	"grid-cols":  always("grid-cols"), "col": always("col"), "col-span": always("col"),
+	"col-start": always("col-start"), "col-end": always("col-end"),
+	"grid-rows": always("grid-rows"), "row": always("row"), "row-span": always("row"),
+	"row-start": always("row-start"), "row-end": always("row-end"),
+	"grid-flow": always("grid-flow"), "auto-cols": always("auto-cols"), "auto-rows": always("auto-rows"),
+	"float": always("float"), "clear": always("clear"),
+	"overflow": always("overflow"), "overflow-x": always("overflow-x"), "overflow-y": always("overflow-y"),
+	"overscroll": always("overscroll"), "overscroll-x": always("overscroll-x"),
+	"overscroll-y": always("overscroll-y"),
+	"object":       oneOf("object-fit", "contain cover fill none scale-down", always("object-position")),
+	"aspect":       always("aspect"), "columns": always("columns"),
+	"text": oneOf("font-size", "xs sm base lg xl 2xl 3xl 4xl 5xl 6xl 7xl 8xl 9xl",
+		oneOf("text-align", "left center right justify start end",
+			oneOf("text-wrap", "wrap nowrap balance pretty",
+				oneOf("text-overflow", "ellipsis clip", lengthOr("font-size", "text-color"))))),
+	"font": oneOf("font-weight", "thin extralight light normal medium semibold bold extrabold black",
+		func(value string) string {
+			if isLength(value) {
+				return "font-weight"
+			}
+			return "font-family"
+		}),
+	"leading": always("leading"), "tracking": always("tracking"),
+	"line-clamp": always("line-clamp"), "list": always("list"),
+	"decoration": oneOf("decoration-style", "solid double dotted dashed wavy",
+		lengthOr("decoration-thickness", "decoration-color")),
+	"underline-offset": always("underline-offset"), "indent": always("indent"),
+	"align": always("vertical-align"), "whitespace": always("whitespace"),
+	"break": always("break"), "hyphens": always("hyphens"),
+	"bg": oneOf("bg-attachment", "fixed local scroll",
+		oneOf("bg-position", "bottom center left left-bottom left-top right right-bottom right-top top",
+			oneOf("bg-repeat", "repeat no-repeat repeat-x repeat-y repeat-round repeat-space",
+				oneOf("bg-size", "auto cover contain",
+					oneOf("bg-image", "none", func(value string) string {
+						if strings.HasPrefix(value, "gradient-") || strings.HasPrefix(value, "linear-") ||
+							strings.HasPrefix(value, "radial") || strings.HasPrefix(value, "conic") {
+							return "bg-image"
+						}
+						return "bg-color"
+					}))))),
+	"bg-clip": always("bg-clip"), "bg-origin": always("bg-origin"), "bg-blend": always("bg-blend"),
+	"from": always("gradient-from"), "via": always("gradient-via"), "to": always("gradient-to"),
+	"border": oneOf("border-style", "solid dashed dotted double hidden none",
+		oneOf("border-collapse", "collapse separate", lengthOr("border-w", "border-color"))),
+	"border-x": lengthOr("border-w-x", "border-color-x"), "border-y": lengthOr("border-w-y", "border-color-y"),
+	"border-t": lengthOr("border-w-t", "border-color-t"), "border-r": lengthOr("border-w-r", "border-color-r"),
+	"border-b": lengthOr("border-w-b", "border-color-b"), "border-l": lengthOr("border-w-l", "border-color-l"),
+	"border-s": lengthOr("border-w-s", "border-color-s"), "border-e": lengthOr("border-w-e", "border-color-e"),
+	"border-spacing": always("border-spacing"),
+	"rounded":        always("rounded"),
+	"rounded-t":      always("rounded-t"), "rounded-r": always("rounded-r"),
+	"rounded-b": always("rounded-b"), "rounded-l": always("rounded-l"),
+	"rounded-s": always("rounded-s"), "rounded-e": always("rounded-e"),
+	"rounded-tl": always("rounded-tl"), "rounded-tr": always("rounded-tr"),
+	"rounded-br": always("rounded-br"), "rounded-bl": always("rounded-bl"),
+	"rounded-ss": always("rounded-ss"), "rounded-se": always("rounded-se"),
+	"rounded-ee": always("rounded-ee"), "rounded-es": always("rounded-es"),
+	"shadow":      oneOf("shadow", "sm md lg xl 2xl inner none", lengthOr("shadow", "shadow-color")),
+	"ring":        oneOf("ring-w", "inset", lengthOr("ring-w", "ring-color")),
+	"ring-offset": lengthOr("ring-offset-w", "ring-offset-color"),
+	"outline": oneOf("outline-style", "dashed dotted double none",
+		lengthOr("outline-w", "outline-color")),
+	"outline-offset": always("outline-offset"),
+	"divide-x":       always("divide-x"), "divide-y": always("divide-y"),
+	"divide": oneOf("divide-style", "solid dashed dotted double none", always("divide-color")),
+	"cursor": always("cursor"), "select": always("select"), "pointer-events": always("pointer-events"),
+	"resize": always("resize"), "appearance": always("appearance"),
+	"accent": always("accent"), "caret": always("caret"), "will-change": always("will-change"),
+	"scroll":     oneOf("scroll-behavior", "auto smooth", always("scroll")),
+	"snap":       oneOf("snap-align", "start end center align-none", always("snap-type")),
+	"touch":      always("touch"),
+	"transition": always("transition"), "duration": always("duration"), "ease": always("ease"),
+	"delay": always("delay"), "animate": always("animate"),
+	"scale": always("scale"), "scale-x": always("scale-x"), "scale-y": always("scale-y"),
+	"rotate": always("rotate"), "translate-x": always("translate-x"), "translate-y": always("translate-y"),
+	"skew-x": always("skew-x"), "skew-y": always("skew-y"), "origin": always("origin"),
+	"fill": always("fill"), "stroke": lengthOr("stroke-w", "stroke"),
+	"blur": always("blur"), "brightness": always("brightness"), "contrast": always("contrast"),
+	"grayscale": always("grayscale"), "invert": always("invert"), "saturate": always("saturate"),
+	"sepia": always("sepia"), "hue-rotate": always("hue-rotate"), "drop-shadow": always("drop-shadow"),
+	"mix-blend":     always("mix-blend"),
+	"backdrop-blur": always("backdrop-blur"), "backdrop-opacity": always("backdrop-opacity"),
+}
+
+// tailwindConflicts lists for a group the groups it overrides when it comes
+// later in the class list
+var tailwindConflicts = map[string][]string{
+	"p":          {"px", "py", "pt", "pr", "pb", "pl", "ps", "pe"},
+	"px":         {"pr", "pl"},
+	"py":         {"pt", "pb"},
+	"m":          {"mx", "my", "mt", "mr", "mb", "ml", "ms", "me"},
+	"mx":         {"mr", "ml"},
+	"my":         {"mt", "mb"},
+	"size":       {"w", "h"},
+	"inset":      {"inset-x", "inset-y", "top", "right", "bottom", "left", "start", "end"},
+	"inset-x":    {"right", "left"},
+	"inset-y":    {"top", "bottom"},
+	"gap":        {"gap-x", "gap-y"},
+	"overflow":   {"overflow-x", "overflow-y"},
+	"overscroll": {"overscroll-x", "overscroll-y"},
+	"truncate":   {"text-overflow", "overflow", "overflow-x", "overflow-y", "whitespace"},
+	"font-size":  {"leading"},
+	"scale":      {"scale-x", "scale-y"},
+	"border-w": {"border-w-x", "border-w-y", "border-w-t", "border-w-r", "border-w-b", "border-w-l",
+		"border-w-s", "border-w-e"},
+	"border-w-x": {"border-w-r", "border-w-l"},
+	"border-w-y": {"border-w-t", "border-w-b"},
+	"border-color": {"border-color-x", "border-color-y", "border-color-t", "border-color-r",
+		"border-color-b", "border-color-l", "border-color-s", "border-color-e"},
+	"border-color-x": {"border-color-r", "border-color-l"},
+	"border-color-y": {"border-color-t", "border-color-b"},
+	"rounded": {"rounded-t", "rounded-r", "rounded-b", "rounded-l", "rounded-s", "rounded-e",
+		"rounded-tl", "rounded-tr", "rounded-br", "rounded-bl", "rounded-ss", "rounded-se",
+		"rounded-ee", "rounded-es"},
+	"rounded-t": {"rounded-tl", "rounded-tr"},
+	"rounded-r": {"rounded-tr", "rounded-br"},
+	"rounded-b": {"rounded-br", "rounded-bl"},
+	"rounded-l": {"rounded-tl", "rounded-bl"},
+	"rounded-s": {"rounded-ss", "rounded-es"},
+	"rounded-e": {"rounded-se", "rounded-ee"},
+}
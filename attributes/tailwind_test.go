@@ -0,0 +1,86 @@
+package attributes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTailwindMerger(t *testing.T) {
+	tests := []struct {
+		name   string
+		merger TailwindMerger
+		in     string
+		want   string
+	}{
+		{"same group", TailwindMerger{}, "p-2 p-4", "p-4"},
+		{"shorthand after side", TailwindMerger{}, "px-4 p-2", "p-2"},
+		{"side after shorthand", TailwindMerger{}, "p-2 px-4", "p-2 px-4"},
+		{"axis after shorthand", TailwindMerger{}, "px-2 pl-4", "px-2 pl-4"},
+		{"shorthand after axis", TailwindMerger{}, "pl-4 px-2", "px-2"},
+		{"variants", TailwindMerger{}, "hover:p-2 p-4", "hover:p-2 p-4"},
+		{"same variants", TailwindMerger{}, "hover:p-2 hover:p-4", "hover:p-4"},
+		{"variant order", TailwindMerger{}, "md:hover:p-2 hover:md:p-4", "hover:md:p-4"},
+		{"important", TailwindMerger{}, "!p-2 p-4", "!p-2 p-4"},
+		{"important suffix", TailwindMerger{}, "!p-2 p-4!", "p-4!"},
+		{"negative", TailwindMerger{}, "-m-2 m-4", "m-4"},
+		{"unknown tokens", TailwindMerger{}, "card p-2 card-body p-4", "card card-body p-4"},
+		{"font size and color", TailwindMerger{}, "text-lg text-red-500 text-sm", "text-red-500 text-sm"},
+		{"font size and leading", TailwindMerger{}, "leading-6 text-lg", "text-lg"},
+		{"arbitrary length", TailwindMerger{}, "text-[12px] text-lg", "text-lg"},
+		{"arbitrary color", TailwindMerger{}, "text-[#fff] text-lg", "text-[#fff] text-lg"},
+		{"opacity modifier", TailwindMerger{}, "bg-red-500/50 bg-blue-500", "bg-blue-500"},
+		{"border width and color", TailwindMerger{}, "border-2 border-red-500 border-4", "border-red-500 border-4"},
+		{"exact", TailwindMerger{}, "block flex hidden", "hidden"},
+		{"longest prefix", TailwindMerger{}, "min-w-4 w-2 min-w-8", "w-2 min-w-8"},
+		{"prefix", TailwindMerger{Prefix: "tw-"}, "tw-p-2 tw-p-4 p-2 p-4", "tw-p-4 p-2 p-4"},
+		{"prefix with variant", TailwindMerger{Prefix: "tw-"}, "hover:tw-p-2 hover:tw-p-4", "hover:tw-p-4"},
+		{
+			"custom group",
+			TailwindMerger{Groups: map[string]string{"text-body": "font-size"}},
+			"text-lg text-body",
+			"text-body",
+		},
+		{
+			"custom prefix",
+			TailwindMerger{Groups: map[string]string{"elevation": "shadow"}},
+			"shadow-lg elevation-2",
+			"elevation-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := strings.Join(tt.merger.MergeClasses(strings.Fields(tt.in)), " ")
+			if got != tt.want {
+				t.Errorf("MergeClasses(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderClasses(t *testing.T) {
+	tests := []struct {
+		name  string
+		attrs []Attribute
+		want  string
+	}{
+		{"repeated tokens", []Attribute{Class_("a b a")}, `class="b a"`},
+		{"repeated attributes", []Attribute{Class_("a b"), Class_("c a")}, `class="b c a"`},
+		{"merger", []Attribute{Class_("p-2 p-4 p-2"), MergeClasses(&TailwindMerger{})}, `class="p-2"`},
+		{"merger first", []Attribute{MergeClasses(&TailwindMerger{}), Class_("px-4"), Class_("p-2")}, `class="p-2"`},
+		{"without merger", []Attribute{Class_("p-2 p-4 p-2")}, `class="p-4 p-2"`},
+		{"merger alone", []Attribute{MergeClasses(&TailwindMerger{})}, ``},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered := []string{}
+			for _, r := range Render(tt.attrs) {
+				rendered = append(rendered, r.String())
+			}
+			if got := strings.Join(rendered, " "); got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,428 @@
+// Package charts renders sparklines, bar and line charts as inline SVG. The
+// charts need no JavaScript, are accessible, using role img with a title
+// and description, and can be styled with CSS using their classes:
+//
+//	.chart            the svg element, along with .chart-sparkline,
+//	                  .chart-bar or .chart-line
+//	.chart-axis       the axes, along with .chart-axis-x or .chart-axis-y
+//	.chart-grid       horizontal grid lines
+//	.chart-tick       tick labels of the y axis
+//	.chart-label      labels of the x axis
+//	.chart-bars       the group of bars, each a .chart-bar-rect, along with
+//	                  .chart-bar-negative for negative values
+//	.chart-line-path  the line of a line or sparkline chart
+//	.chart-point      the points of a line chart, and the last point of a
+//	                  sparkline along with .chart-point-last
+//
+// Lines are drawn with stroke currentColor and no fill, so that a chart
+// takes the text color by default. Output is deterministic: coordinates are
+// rounded to two decimals, and ids are derived from the chart's contents
+// unless set in the options.
+package charts
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	h "github.com/julvo/htmlgo"
+	a "github.com/julvo/htmlgo/attributes"
+	"github.com/julvo/htmlgo/svg"
+	sa "github.com/julvo/htmlgo/svg/attributes"
+)
+
+// Options configure a chart. Zero values select the defaults.
+type Options struct {
+	// Width and Height of the chart in px, defaulting to 100x20 for
+	// sparklines and 400x200 for other charts
+	Width, Height float64
+	// Title is the accessible name of the chart, defaulting to the kind of
+	// chart
+	Title string
+	// Desc describes the chart for screen readers, defaulting to a summary
+	// of the values
+	Desc string
+	// ID prefixes the ids of the title and description. It defaults to a
+	// hash of the chart, so it should be set when the same chart occurs
+	// twice on a page.
+	ID string
+	// Class is added to the classes of the svg element
+	Class string
+	// Labels of the values, shown along the x axis
+	Labels []string
+	// Ticks is the approximate number of ticks of the y axis, defaulting
+	// to 5
+	Ticks int
+}
+
+const (
+	padTop    = 10.0
+	padRight  = 10.0
+	padBottom = 24.0
+	padLeft   = 40.0
+
+	// maxTicks bounds the number of ticks of the y axis
+	maxTicks = 100
+)
+
+// Sparkline renders values as a small line without axes, marking the last
+// value. NaN and infinite values leave gaps.
+func Sparkline(values []float64, opts Options) h.HTML {
+	opts = defaults(opts, "sparkline", 100, 20)
+	min, max := extent(values)
+	if min == max {
+		min, max = min-1, max+1
+	}
+	x := linear(0, float64(len(values)-1), 2, opts.Width-2)
+	if len(values) == 1 {
+		x = func(float64) float64 { return opts.Width / 2 }
+	}
+	y := linear(min, max, opts.Height-2, 2)
+
+	children := []h.HTML{}
+	if d := linePath(values, x, y); d != "" {
+		children = append(children, svg.Path(h.Attr(a.Class_("chart-line-path"), sa.D(d),
+			sa.Fill_("none"), sa.Stroke_("currentColor"))))
+	}
+	if last := len(values) - 1; last >= 0 && finite(values[last]) {
+		children = append(children, svg.Circle(h.Attr(a.Class_("chart-point chart-point-last"),
+			sa.Cx(num(x(float64(last)))), sa.Cy(num(y(values[last]))), sa.R_("1.5"),
+			sa.Fill_("currentColor"))))
+	}
+	return root("sparkline", values, opts, children...)
+}
+
+// Bar renders values as vertical bars starting at zero, with a y axis and
+// the labels along the x axis
+func Bar(values []float64, opts Options) h.HTML {
+	opts = defaults(opts, "bar", 400, 200)
+	min, max := extent(values)
+	min, max = math.Min(min, 0), math.Max(max, 0)
+	lo, hi, step := niceScale(min, max, opts.Ticks)
+	y := linear(lo, hi, opts.Height-padBottom, padTop)
+
+	slot := (opts.Width - padLeft - padRight) / math.Max(float64(len(values)), 1)
+	center := func(i float64) float64 { return padLeft + slot*(i+0.5) }
+
+	bars := []h.HTML{}
+	for i, v := range values {
+		if !finite(v) {
+			continue
+		}
+		top, bottom := y(math.Max(v, 0)), y(math.Min(v, 0))
+		class := "chart-bar-rect"
+		if v < 0 {
+			class += " chart-bar-negative"
+		}
+		bars = append(bars, svg.Rect(h.Attr(a.Class_(class),
+			sa.X(num(padLeft+slot*float64(i)+slot*0.1)), sa.Y(num(top)),
+			sa.Width(num(slot*0.8)), sa.Height(num(bottom-top)),
+			sa.Fill_("currentColor"))))
+	}
+
+	children := yAxis(lo, hi, step, y, opts)
+	children = append(children, svg.G(h.Attr(a.Class_("chart-bars")), bars...))
+	children = append(children, xAxis(y(0), len(values), center, opts)...)
+	return root("bar", values, opts, children...)
+}
+
+// Line renders values as a line with points, a y axis and the labels along
+// the x axis. NaN and infinite values leave gaps.
+func Line(values []float64, opts Options) h.HTML {
+	opts = defaults(opts, "line", 400, 200)
+	min, max := extent(values)
+	lo, hi, step := niceScale(min, max, opts.Ticks)
+	y := linear(lo, hi, opts.Height-padBottom, padTop)
+
+	slot := (opts.Width - padLeft - padRight) / math.Max(float64(len(values)), 1)
+	center := func(i float64) float64 { return padLeft + slot*(i+0.5) }
+
+	children := yAxis(lo, hi, step, y, opts)
+	if d := linePath(values, center, y); d != "" {
+		children = append(children, svg.Path(h.Attr(a.Class_("chart-line-path"), sa.D(d),
+			sa.Fill_("none"), sa.Stroke_("currentColor"))))
+	}
+	for i, v := range values {
+		if !finite(v) {
+			continue
+		}
+		children = append(children, svg.Circle(h.Attr(a.Class_("chart-point"),
+			sa.Cx(num(center(float64(i)))), sa.Cy(num(y(v))), sa.R_("2.5"),
+			sa.Fill_("currentColor"))))
+	}
+	children = append(children, xAxis(opts.Height-padBottom, len(values), center, opts)...)
+	return root("line", values, opts, children...)
+}
+
+var titles = map[string]string{
+	"sparkline": "Sparkline",
+	"bar":       "Bar chart",
+	"line":      "Line chart",
+}
+
+func defaults(opts Options, kind string, width, height float64) Options {
+	if opts.Width <= 0 {
+		opts.Width = width
+	}
+	if opts.Height <= 0 {
+		opts.Height = height
+	}
+	if opts.Title == "" {
+		opts.Title = titles[kind]
+	}
+	if opts.Ticks <= 0 {
+		opts.Ticks = 5
+	}
+	if opts.Ticks > maxTicks {
+		opts.Ticks = maxTicks
+	}
+	return opts
+}
+
+// root wraps the parts of a chart into an accessible svg element
+func root(kind string, values []float64, opts Options, children ...h.HTML) h.HTML {
+	id := opts.ID
+	if id == "" {
+		id = chartID(kind, values, opts)
+	}
+	desc := opts.Desc
+	if desc == "" {
+		desc = summary(values, opts.Labels)
+	}
+	class := "chart chart-" + kind
+	if opts.Class != "" {
+		class += " " + opts.Class
+	}
+
+	children = append([]h.HTML{
+		svg.Title(h.Attr(a.Id(id+"-title")), h.Text(opts.Title)),
+		svg.Desc(h.Attr(a.Id(id+"-desc")), h.Text(desc)),
+	}, children...)
+	return svg.Svg(h.Attr(
+		a.Classes(class),
+		a.Role_("img"),
+		a.AriaLabelledby(id+"-title"),
+		a.AriaDescribedby(id+"-desc"),
+		sa.Width(num(opts.Width)),
+		sa.Height(num(opts.Height)),
+		sa.ViewBox("0 0 "+num(opts.Width)+" "+num(opts.Height)),
+	), children...)
+}
+
+// chartID derives an id from the contents of a chart
+func chartID(kind string, values []float64, opts Options) string {
+	s := kind + "\n" + opts.Title + "\n" + strings.Join(opts.Labels, "\n")
+	for _, v := range values {
+		s += "\n" + strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	hash := sha256.Sum256([]byte(s))
+	return "chart-" + hex.EncodeToString(hash[:])[:8]
+}
+
+// summary describes the values for screen readers
+func summary(values []float64, labels []string) string {
+	min, max := extent(values)
+	n := 0
+	for _, v := range values {
+		if finite(v) {
+			n++
+		}
+	}
+	if n == 0 {
+		return "No values."
+	}
+	s := fmt.Sprintf("%d values from %s to %s", n, value(min), value(max))
+	if len(labels) > 0 && len(labels) >= len(values) {
+		s += fmt.Sprintf(", labelled %s to %s", labels[0], labels[len(values)-1])
+	}
+	last := values[len(values)-1]
+	if finite(last) {
+		s += ", last value " + value(last)
+	}
+	return s + "."
+}
+
+func yAxis(lo, hi, step float64, y func(float64) float64, opts Options) []h.HTML {
+	parts := []h.HTML{svg.Line(h.Attr(a.Class_("chart-axis chart-axis-y"),
+		sa.X1(num(padLeft)), sa.X2(num(padLeft)),
+		sa.Y1(num(padTop)), sa.Y2(num(opts.Height-padBottom)),
+		sa.Stroke_("currentColor")))}
+	if !(step > 0) || math.IsInf(step, 0) {
+		return parts
+	}
+	for i := 0; i <= 2*maxTicks; i++ {
+		// halved, so that the ticks of large values do not overflow
+		v := (lo/2 + step/2*float64(i)) * 2
+		if v-step/2 > hi {
+			break
+		}
+		parts = append(parts,
+			svg.Line(h.Attr(a.Class_("chart-grid"),
+				sa.X1(num(padLeft)), sa.X2(num(opts.Width-padRight)),
+				sa.Y1(num(y(v))), sa.Y2(num(y(v))),
+				sa.Stroke_("currentColor"), sa.StrokeOpacity_("0.2"))),
+			svg.Text(h.Attr(a.Class_("chart-tick"),
+				sa.X(num(padLeft-4)), sa.Y(num(y(v))),
+				sa.TextAnchor_("end"), sa.DominantBaseline_("middle"), sa.FontSize_("10")),
+				h.Text(tick(v, step))))
+	}
+	return parts
+}
+
+func xAxis(baseline float64, n int, center func(float64) float64, opts Options) []h.HTML {
+	parts := []h.HTML{svg.Line(h.Attr(a.Class_("chart-axis chart-axis-x"),
+		sa.X1(num(padLeft)), sa.X2(num(opts.Width-padRight)),
+		sa.Y1(num(baseline)), sa.Y2(num(baseline)),
+		sa.Stroke_("currentColor")))}
+	for i, label := range opts.Labels {
+		if i >= n {
+			break
+		}
+		parts = append(parts, svg.Text(h.Attr(a.Class_("chart-label"),
+			sa.X(num(center(float64(i)))), sa.Y(num(opts.Height-padBottom+14)),
+			sa.TextAnchor_("middle"), sa.FontSize_("10")),
+			h.Text(label)))
+	}
+	return parts
+}
+
+// linePath builds the path data of a line through the values, starting a
+// new subpath after each NaN or infinite value
+func linePath(values []float64, x, y func(float64) float64) string {
+	parts := []string{}
+	move := true
+	for i, v := range values {
+		if !finite(v) {
+			move = true
+			continue
+		}
+		cmd := "L"
+		if move {
+			cmd = "M"
+			move = false
+		}
+		parts = append(parts, cmd+num(x(float64(i)))+" "+num(y(v)))
+	}
+	return strings.Join(parts, " ")
+}
+
+// extent returns the minimum and maximum of the values, ignoring NaN and
+// infinite values and returning 0, 0 if there are no values
+func extent(values []float64) (float64, float64) {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if !finite(v) {
+			continue
+		}
+		min, max = math.Min(min, v), math.Max(max, v)
+	}
+	if math.IsInf(min, 1) {
+		return 0, 0
+	}
+	return min, max
+}
+
+// finite reports whether v is neither NaN nor infinite, i.e. can be drawn
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// linear maps the domain d0..d1 to the range r0..r1
+func linear(d0, d1, r0, r1 float64) func(float64) float64 {
+	if d0 == d1 {
+		return func(float64) float64 { return (r0 + r1) / 2 }
+	}
+	// halved, so that the span of large values does not overflow
+	return func(v float64) float64 {
+		return r0 + (v/2-d0/2)/(d1/2-d0/2)*(r1-r0)
+	}
+}
+
+// niceScale extends min and max to round numbers, returning the bounds and
+// the step between about ticks ticks. Bounds which would overflow are kept,
+// and the step is 0 if the range has no finite step.
+func niceScale(min, max float64, ticks int) (float64, float64, float64) {
+	if min == max {
+		min, max = min-1, max+1
+		if min == max {
+			// too large to change by one
+			min, max = math.Min(min, 0), math.Max(max, 0)
+		}
+	}
+	// divided first, so that the span of large values does not overflow
+	step := niceNumber(max/float64(ticks) - min/float64(ticks))
+	if !(step > 0) || math.IsInf(step, 0) {
+		return min, max, 0
+	}
+	lo, hi := math.Floor(min/step)*step, math.Ceil(max/step)*step
+	if math.IsInf(lo, 0) {
+		lo = min
+	}
+	if math.IsInf(hi, 0) {
+		hi = max
+	}
+	return lo, hi, step
+}
+
+// niceNumber rounds x to 1, 2 or 5 times a power of ten
+func niceNumber(x float64) float64 {
+	if !finite(x) || x <= 0 {
+		return x
+	}
+	exp := math.Floor(math.Log10(x))
+	f := x / math.Pow(10, exp)
+	switch {
+	case f <= 1:
+		f = 1
+	case f <= 2:
+		f = 2
+	case f <= 5:
+		f = 5
+	default:
+		f = 10
+	}
+	// parsed, as multiplying by a power of ten is inexact for large exponents
+	nice, _ := strconv.ParseFloat(strconv.Itoa(int(f))+"e"+strconv.Itoa(int(exp)), 64)
+	return nice
+}
+
+// value formats a data value with all its digits, using an exponent for
+// very small or large values
+func value(v float64) string {
+	if v == 0 || (math.Abs(v) >= 1e-6 && math.Abs(v) < 1e15) {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// tick formats the value of a tick with the decimals of the step between
+// ticks, which is 1, 2 or 5 times a power of ten
+func tick(v, step float64) string {
+	if math.Abs(v) < step/2 {
+		// avoid -0 and rounding errors of the ticks
+		return "0"
+	}
+	exp := int(math.Floor(math.Log10(step)))
+	if exp < -6 || math.Abs(v) >= 1e15 {
+		digits := int(math.Floor(math.Log10(math.Abs(v)))) - exp + 1
+		return strconv.FormatFloat(v, 'g', digits, 64)
+	}
+	decimals := 0
+	if exp < 0 {
+		decimals = -exp
+	}
+	return strconv.FormatFloat(v, 'f', decimals, 64)
+}
+
+// num formats a coordinate with at most two decimals
+func num(x float64) string {
+	x = math.Round(x*100) / 100
+	if x == 0 {
+		// avoid -0
+		x = 0
+	}
+	return strconv.FormatFloat(x, 'f', -1, 64)
+}
@@ -0,0 +1,174 @@
+package charts
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestNiceScale(t *testing.T) {
+	tests := []struct {
+		name         string
+		min, max     float64
+		ticks        int
+		lo, hi, step float64
+	}{
+		{"unit range", 0, 1, 5, 0, 1, 0.2},
+		{"round up", 3, 97, 5, 0, 100, 20},
+		{"negative", -12, 7, 4, -15, 10, 5},
+		{"small values", 0.001, 0.004, 5, 0.001, 0.004, 0.001},
+		{"equal values", 5, 5, 5, 4, 6, 0.5},
+		{"equal zero", 0, 0, 5, -1, 1, 0.5},
+		{"equal large values", 1e300, 1e300, 5, 0, 1e300, 2e299},
+		{"largest span", -math.MaxFloat64, math.MaxFloat64, 5, -math.MaxFloat64, math.MaxFloat64, 1e308},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lo, hi, step := niceScale(tt.min, tt.max, tt.ticks)
+			if lo != tt.lo || hi != tt.hi || step != tt.step {
+				t.Errorf("niceScale(%v, %v, %d) = %v, %v, %v, want %v, %v, %v",
+					tt.min, tt.max, tt.ticks, lo, hi, step, tt.lo, tt.hi, tt.step)
+			}
+		})
+	}
+}
+
+func TestExtent(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		min, max float64
+	}{
+		{"empty", nil, 0, 0},
+		{"values", []float64{3, -1, 2}, -1, 3},
+		{"NaN", []float64{math.NaN(), 1, math.NaN()}, 1, 1},
+		{"infinite", []float64{math.Inf(-1), 1, 2, math.Inf(1)}, 1, 2},
+		{"no finite values", []float64{math.NaN(), math.Inf(1)}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if min, max := extent(tt.values); min != tt.min || max != tt.max {
+				t.Errorf("extent(%v) = %v, %v, want %v, %v", tt.values, min, max, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestTick(t *testing.T) {
+	tests := []struct {
+		v, step float64
+		want    string
+	}{
+		{0, 1, "0"},
+		{20, 5, "20"},
+		{-15, 5, "-15"},
+		{0.6, 0.2, "0.6"},
+		{0.30000000000000004, 0.1, "0.3"},
+		{-1e-17, 0.1, "0"},
+		{0.004, 0.001, "0.004"},
+		{3e-9, 1e-9, "3e-09"},
+		{2e20, 1e20, "2e+20"},
+		{-1.5e300, 5e299, "-1.5e+300"},
+	}
+
+	for _, tt := range tests {
+		if got := tick(tt.v, tt.step); got != tt.want {
+			t.Errorf("tick(%v, %v) = %q, want %q", tt.v, tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	tests := []struct {
+		v    float64
+		want string
+	}{
+		{0, "0"},
+		{42, "42"},
+		{-0.5, "-0.5"},
+		{0.001, "0.001"},
+		{0.123456, "0.123456"},
+		{1e-7, "1e-07"},
+		{1e20, "1e+20"},
+		{math.MaxFloat64, "1.7976931348623157e+308"},
+	}
+
+	for _, tt := range tests {
+		if got := value(tt.v); got != tt.want {
+			t.Errorf("value(%v) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestSummary(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		labels []string
+		want   string
+	}{
+		{"empty", nil, nil, "No values."},
+		{"no finite values", []float64{math.NaN()}, nil, "No values."},
+		{"values", []float64{1, 3, 2}, nil, "3 values from 1 to 3, last value 2."},
+		{"small values", []float64{0.001, 0.004, 0.003}, nil, "3 values from 0.001 to 0.004, last value 0.003."},
+		{"labels", []float64{1, 2}, []string{"Jan", "Feb"}, "2 values from 1 to 2, labelled Jan to Feb, last value 2."},
+		{"too few labels", []float64{1, 2}, []string{"Jan"}, "2 values from 1 to 2, last value 2."},
+		{"last value NaN", []float64{1, 2, math.NaN()}, nil, "2 values from 1 to 2."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summary(tt.values, tt.labels); got != tt.want {
+				t.Errorf("summary(%v, %v) = %q, want %q", tt.values, tt.labels, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCharts(t *testing.T) {
+	charts := map[string]func([]float64, Options) string{
+		"sparkline": func(v []float64, o Options) string { return string(Sparkline(v, o)) },
+		"bar":       func(v []float64, o Options) string { return string(Bar(v, o)) },
+		"line":      func(v []float64, o Options) string { return string(Line(v, o)) },
+	}
+	tests := []struct {
+		name   string
+		values []float64
+		opts   Options
+		want   []string
+	}{
+		{"empty", nil, Options{}, []string{"No values."}},
+		{"small values", []float64{0.001, 0.004, 0.003}, Options{}, []string{"0.001 to 0.004"}},
+		{"NaN and infinite", []float64{1, math.NaN(), math.Inf(1), 2}, Options{}, []string{"2 values from 1 to 2"}},
+		{"extreme values", []float64{-1e308, 1e308}, Options{}, []string{"from -1e+308 to 1e+308"}},
+		{"largest values", []float64{-math.MaxFloat64, math.MaxFloat64, math.MaxFloat64}, Options{}, nil},
+		{"many ticks", []float64{0, 1}, Options{Ticks: 1 << 30}, nil},
+		{"options", []float64{1}, Options{Title: "Sales", ID: "s", Class: "wide"}, []string{
+			`id="s-title"`, ">Sales</title>", "wide",
+		}},
+	}
+
+	for kind, render := range charts {
+		for _, tt := range tests {
+			t.Run(kind+"/"+tt.name, func(t *testing.T) {
+				got := render(tt.values, tt.opts)
+				if !strings.HasPrefix(strings.TrimSpace(got), "<svg") {
+					t.Fatalf("got %q, want an svg element", got)
+				}
+				if strings.Contains(got, "NaN") || strings.Contains(got, "Inf") {
+					t.Errorf("got %q, want no NaN or infinite coordinates", got)
+				}
+				if n := strings.Count(got, "chart-tick"); n > 2*maxTicks+1 {
+					t.Errorf("got %d ticks, want at most %d", n, 2*maxTicks+1)
+				}
+				for _, want := range tt.want {
+					if !strings.Contains(got, want) {
+						t.Errorf("got %q, want it to contain %q", got, want)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package htmlgo
+
+import (
+	"strings"
+)
+
+const (
+	headOnceStart = "<!--htmlgo:head "
+	headOnceEnd   = "<!--/htmlgo:head-->"
+	onceStart     = "<!--htmlgo:once "
+	onceEnd       = "<!--/htmlgo:once-->"
+)
+
+// Once marks content which is rendered once per page, keeping it in place,
+// such as the symbols of an SVG sprite. Collect drops repeated content of
+// the same key. Without Collect, e.g. when rendering a fragment, all
+// content stays in place.
+func Once(key string, content HTML) HTML {
+	return HTML("\n"+onceStart+commentSafe(key)+"-->") + content + HTML("\n"+onceEnd)
+}
+
+// HeadOnce marks content which belongs into the head of the document, such
+// as the styles of a component. Collect moves the content into the head
+// element, keeping only the first content of each key, so that a component
+// used several times contributes its styles once. Without Collect, e.g. when
+// rendering a fragment, the content stays in place.
+func HeadOnce(key string, content HTML) HTML {
+	return HTML("\n"+headOnceStart+commentSafe(key)+"-->") + content + HTML("\n"+headOnceEnd)
+}
+
+// Collect moves the content marked by HeadOnce to the end of the head
+// element of a page, dropping repeated content of the same key, and drops
+// repeated content marked by Once. If the page has no head element, the
+// first content of each key stays in place along with its markers, so that
+// the page can be collected again when it is embedded. Html5 collects
+// automatically.
+func Collect(page HTML) HTML {
+	s := string(page)
+	hasHead := strings.Contains(s, "</head>")
+	if strings.Contains(s, onceStart) {
+		s = collectOnce(s, map[string]struct{}{}, hasHead)
+	}
+	if !strings.Contains(s, headOnceStart) {
+		return HTML(s)
+	}
+
+	out, collected := collectHead(s, map[string]struct{}{}, hasHead)
+
+	headEnd := strings.Index(out, "</head>")
+	if headEnd < 0 {
+		return HTML(out)
+	}
+	lineStart := strings.LastIndex(out[:headEnd], "\n") + 1
+	indentation := out[lineStart:headEnd]
+	if strings.TrimSpace(indentation) != "" {
+		// the end tag follows other content, e.g. <head></head>
+		indentation = indentation[:len(indentation)-len(strings.TrimLeft(indentation, " \t"))]
+		return HTML(out[:headEnd] + indent(collected, indentation+"  ") + "\n" + indentation + out[headEnd:])
+	}
+	return HTML(out[:lineStart] + strings.TrimPrefix(indent(collected, indentation+"  "), "\n") + "\n" + out[lineStart:])
+}
+
+// collectHead removes the content marked by HeadOnce from s and returns it
+// separately, keeping only the first content of each key. Content marked
+// within marked content is collected alongside it. Without a head, the
+// first content of each key stays in place along with its markers.
+func collectHead(s string, seen map[string]struct{}, hasHead bool) (string, string) {
+	out := ""
+	collected := ""
+	for {
+		start := strings.Index(s, headOnceStart)
+		if start < 0 {
+			break
+		}
+		keyEnd := strings.Index(s[start:], "-->")
+		end := matchingEnd(s[start:], headOnceStart, headOnceEnd)
+		if keyEnd < 0 || end < 0 {
+			break
+		}
+		key := s[start+len(headOnceStart) : start+keyEnd]
+		content := s[start+keyEnd+len("-->") : start+end]
+		before := s[:start]
+
+		s = s[start+end+len(headOnceEnd):]
+
+		if _, repeated := seen[key]; repeated {
+			// drop the line break and indentation preceding the marker
+			out += strings.TrimSuffix(strings.TrimRight(before, " \t"), "\n")
+			continue
+		}
+		seen[key] = struct{}{}
+		content, nested := collectHead(content, seen, hasHead)
+		if hasHead {
+			out += strings.TrimSuffix(strings.TrimRight(before, " \t"), "\n")
+			collected += nested + strings.TrimRight(dedent(content), " \t\n")
+		} else {
+			out += before + headOnceStart + key + "-->" + content + headOnceEnd
+		}
+	}
+	return out + s, collected
+}
+
+// collectOnce drops repeated content marked by Once. The markers of the
+// first content of each key are removed if strip is true.
+func collectOnce(s string, seen map[string]struct{}, strip bool) string {
+	out := ""
+	for {
+		start := strings.Index(s, onceStart)
+		if start < 0 {
+			break
+		}
+		keyEnd := strings.Index(s[start:], "-->")
+		end := matchingEnd(s[start:], onceStart, onceEnd)
+		if keyEnd < 0 || end < 0 {
+			break
+		}
+		key := s[start+len(onceStart) : start+keyEnd]
+		content := s[start+keyEnd+len("-->") : start+end]
+		before := s[:start]
+
+		s = s[start+end+len(onceEnd):]
+
+		if _, repeated := seen[key]; repeated {
+			// drop the line break and indentation preceding the marker
+			out += strings.TrimSuffix(strings.TrimRight(before, " \t"), "\n")
+			continue
+		}
+		seen[key] = struct{}{}
+		content = collectOnce(content, seen, strip)
+		if strip {
+			// the content is indented like the markers
+			out += strings.TrimSuffix(strings.TrimRight(before, " \t"), "\n") +
+				strings.TrimRight(content, " \t\n")
+		} else {
+			out += before + onceStart + key + "-->" + content + onceEnd
+		}
+	}
+	return out + s
+}
+
+// matchingEnd returns the index of the end marker matching the start marker
+// at the start of s, skipping pairs of markers nested in between, or -1
+func matchingEnd(s, startMarker, endMarker string) int {
+	depth := 0
+	for i := 0; ; {
+		nextStart := strings.Index(s[i:], startMarker)
+		nextEnd := strings.Index(s[i:], endMarker)
+		if nextEnd < 0 {
+			return -1
+		}
+		if nextStart >= 0 && nextStart < nextEnd {
+			depth++
+			i += nextStart + len(startMarker)
+			continue
+		}
+		depth--
+		if depth == 0 {
+			return i + nextEnd
+		}
+		i += nextEnd + len(endMarker)
+	}
+}
+
+// dedent removes the indentation of the first line from all lines
+func dedent(s string) string {
+	trimmed := strings.TrimLeft(s, "\n")
+	indentation := trimmed[:len(trimmed)-len(strings.TrimLeft(trimmed, " \t"))]
+	if indentation == "" {
+		return s
+	}
+	return strings.Replace(s, "\n"+indentation, "\n", -1)
+}
+
+// commentSafe removes character sequences which must not occur in comments
+func commentSafe(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r == '<' || r == '>' || r == '!' {
+			return '_'
+		}
+		return r
+	}, s)
+	for strings.Contains(s, "--") {
+		s = strings.Replace(s, "--", "-", -1)
+	}
+	return strings.Trim(s, "-")
+}
@@ -0,0 +1,219 @@
+package htmlgo
+
+import (
+	"fmt"
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// Component is a web component defined in Go. Its definition consists of a
+// <template> holding the contents of the component's shadow root and a
+// script registering the custom element:
+//
+//	var greeting = MustComponent("x-greeting", []string{"title"},
+//	    B_(Slot_()),
+//	    JavaScript_(`attributeChangedCallback(name, old, value) {
+//	        this.shadowRoot.querySelector("b").title = value;
+//	    }`))
+//
+//	greeting.Element(Attr(a.Title("Hello")), Text("World"))
+//
+// The class body, passed as JS, is placed into a class extending HTMLElement,
+// whose constructor attaches the shadow root unless the element already has
+// one, e.g. from a declarative shadow root.
+type Component struct {
+	name     string
+	observed []string
+	template HTML
+	class    JS
+}
+
+// NewComponent defines a web component. The name must be a valid custom
+// element name, i.e. start with a lowercase letter and contain a hyphen.
+// Changes of the observed attributes are passed to the class's
+// attributeChangedCallback.
+// Content of the template marked by HeadOnce, e.g. the stylesheet of a
+// Scope, stays within the template, as the styles of the document do not
+// apply within shadow roots.
+func NewComponent(name string, observed []string, template HTML, class JS) (*Component, error) {
+	if err := ValidateCustomElementName(name); err != nil {
+		return nil, err
+	}
+	return &Component{
+		name:     name,
+		observed: append([]string{}, observed...),
+		template: template,
+		class:    class,
+	}, nil
+}
+
+// MustComponent is like NewComponent but panics if the name is invalid. It
+// is intended for package-level variables.
+func MustComponent(name string, observed []string, template HTML, class JS) *Component {
+	c, err := NewComponent(name, observed, template, class)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Name returns the tag name of the component
+func (c *Component) Name() string {
+	return c.name
+}
+
+func (c *Component) templateID() string {
+	return "htmlgo-component-" + c.name
+}
+
+// Definition returns the template and the script defining the component,
+// preceded by the definitions of components used in its template
+func (c *Component) Definition() HTML {
+	definition, dependencies := c.definition()
+	return dependencies + definition
+}
+
+// definition returns the definition of the component and, separately, the
+// definitions of the components used in its template
+func (c *Component) definition() (HTML, HTML) {
+	template, dependencies := inlineHeadOnce(c.template, map[string]struct{}{})
+	body := ""
+	if c.class.templ != "" {
+		body = `  {%$template "class" .Data$%}` + "\n"
+	}
+	class := JS{
+		templ: `{%$define "class"$%}` + c.class.templ + `{%$end$%}` +
+			`customElements.define({%$.Name$%}, class extends HTMLElement {
+  static get observedAttributes() { return {%$.Observed$%}; }
+  constructor() {
+    super();
+    if (!this.shadowRoot) {
+      const template = document.getElementById({%$.TemplateID$%});
+      this.attachShadow({mode: "open"}).appendChild(template.content.cloneNode(true));
+    }
+  }
+` + body + `});`,
+		data: map[string]interface{}{
+			"Name":       c.name,
+			"Observed":   c.observed,
+			"TemplateID": c.templateID(),
+			"Data":       c.class.data,
+		},
+	}
+	return Template(Attr(a.Id(c.templateID())), template) + Script_(class), dependencies
+}
+
+// Element builds an instance of the component. The definition is included
+// using HeadOnce, so that it is rendered once per page, and so are the
+// definitions of components used in its template. The method value
+// has the signature of the generated element functions, e.g.
+//
+//	var XGreeting = greeting.Element
+func (c *Component) Element(attrs []a.Attribute, children ...HTML) HTML {
+	definition, dependencies := c.definition()
+	return dependencies + HeadOnce(componentKey+c.name, definition) + Element(c.name, attrs, children...)
+}
+
+func (c *Component) Element_(children ...HTML) HTML {
+	return c.Element(Attr(), children...)
+}
+
+// ShadowElement builds an instance of the component whose shadow root is
+// rendered as declarative shadow root, so that it is displayed before the
+// script defining the component runs
+func (c *Component) ShadowElement(attrs []a.Attribute, children ...HTML) HTML {
+	template, _ := inlineHeadOnce(c.template, map[string]struct{}{})
+	children = append([]HTML{ShadowRoot_(template)}, children...)
+	definition, dependencies := c.definition()
+	return dependencies + HeadOnce(componentKey+c.name, definition) + Element(c.name, attrs, children...)
+}
+
+// componentKey prefixes the HeadOnce keys of component definitions
+const componentKey = "component:"
+
+// inlineHeadOnce resolves the HeadOnce markers of content rendered into a
+// shadow root, where the styles of the document's head do not apply. Marked
+// content stays in place without markers, once per key, except for the
+// definitions of components, which are returned separately along with their
+// markers, so that they are included in the document.
+func inlineHeadOnce(content HTML, seen map[string]struct{}) (HTML, HTML) {
+	s := string(content)
+	out := ""
+	definitions := HTML("")
+	for {
+		start := strings.Index(s, headOnceStart)
+		if start < 0 {
+			break
+		}
+		keyEnd := strings.Index(s[start:], "-->")
+		end := matchingEnd(s[start:], headOnceStart, headOnceEnd)
+		if keyEnd < 0 || end < 0 {
+			break
+		}
+		key := s[start+len(headOnceStart) : start+keyEnd]
+		marked := s[start : start+end+len(headOnceEnd)]
+		inner := s[start+keyEnd+len("-->") : start+end]
+		// drop the line break and indentation preceding the marker
+		out += strings.TrimSuffix(strings.TrimRight(s[:start], " \t"), "\n")
+
+		s = s[start+end+len(headOnceEnd):]
+
+		if strings.HasPrefix(key, componentKey) {
+			definitions += HTML("\n" + dedent(marked))
+			continue
+		}
+		if _, repeated := seen[key]; repeated {
+			continue
+		}
+		seen[key] = struct{}{}
+		inlined, nested := inlineHeadOnce(HTML(inner), seen)
+		definitions += nested
+		out += strings.TrimRight(string(inlined), " \t\n")
+	}
+	return HTML(out + s), definitions
+}
+
+// reservedCustomElementNames contain a hyphen but are used by SVG and MathML
+var reservedCustomElementNames = []string{
+	"annotation-xml", "color-profile", "font-face", "font-face-src",
+	"font-face-uri", "font-face-format", "font-face-name", "missing-glyph",
+}
+
+// ValidateCustomElementName checks that name is a valid custom element
+// name, see https://html.spec.whatwg.org/multipage/custom-elements.html#valid-custom-element-name.
+// It is also used by htmlgogen to check custom element specs.
+func ValidateCustomElementName(name string) error {
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		return fmt.Errorf("htmlgo: custom element name %q must start with a lowercase ASCII letter", name)
+	}
+	if !strings.Contains(name, "-") {
+		return fmt.Errorf("htmlgo: custom element name %q must contain a hyphen", name)
+	}
+	for _, reserved := range reservedCustomElementNames {
+		if name == reserved {
+			return fmt.Errorf("htmlgo: custom element name %q is reserved", name)
+		}
+	}
+	for _, r := range name {
+		if !isPCENChar(r) {
+			return fmt.Errorf("htmlgo: custom element name %q must not contain %q", name, r)
+		}
+	}
+	return nil
+}
+
+// isPCENChar reports whether r may occur in a custom element name
+func isPCENChar(r rune) bool {
+	switch {
+	case r == '-', r == '.', r == '_', r == 0xB7:
+	case r >= '0' && r <= '9', r >= 'a' && r <= 'z':
+	case r >= 0xC0 && r <= 0xD6, r >= 0xD8 && r <= 0xF6, r >= 0xF8 && r <= 0x37D:
+	case r >= 0x37F && r <= 0x1FFF, r >= 0x200C && r <= 0x200D, r >= 0x203F && r <= 0x2040:
+	case r >= 0x2070 && r <= 0x218F, r >= 0x2C00 && r <= 0x2FEF, r >= 0x3001 && r <= 0xD7FF:
+	case r >= 0xF900 && r <= 0xFDCF, r >= 0xFDF0 && r <= 0xFFFD, r >= 0x10000 && r <= 0xEFFFF:
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+// Package css provides typed CSS declarations for inline styles:
+//
+//	Div(Attr(css.Style(css.Display(css.Flex), css.Width(css.Px(10)), css.Custom("--gap", css.Rem(1)))))
+//
+// Values constructed by this package are safe to use in a style attribute.
+// Unchecked values can be passed using Raw, which rejects any value that
+// html/template would replace by ZgotmplZ in a CSS context.
+//
+// Declarations can also be combined into rules of a stylesheet, see Sheet.
+package css
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// Value is a CSS value. It can only be implemented by this package, so that
+// every Value is either constructed safely or validated.
+type Value interface {
+	css() (string, error)
+}
+
+// Declaration is a CSS property together with its value
+type Declaration struct {
+	property string
+	values   []Value
+}
+
+// Prop declares an arbitrary property. Several values are separated by
+// spaces, e.g. Prop("margin", Px(0), Auto).
+func Prop(property string, values ...Value) Declaration {
+	return Declaration{property: property, values: values}
+}
+
+// Custom declares a custom property. The leading -- of its name may be
+// omitted, e.g. Custom("gap", Rem(1)) declares --gap.
+func Custom(property string, values ...Value) Declaration {
+	return Declaration{property: "--" + strings.TrimPrefix(property, "--"), values: values}
+}
+
+// Property returns the name of the declared property
+func (d Declaration) Property() string {
+	return d.property
+}
+
+// Render returns the declaration as property:value, or an error if the
+// property or one of its values is invalid
+func (d Declaration) Render() (string, error) {
+	property, value, err := d.render()
+	if err != nil {
+		return "", err
+	}
+	return property + ":" + value, nil
+}
+
+func (d Declaration) render() (string, string, error) {
+	if !isProperty(d.property) {
+		return "", "", fmt.Errorf("css: invalid property name %q", d.property)
+	}
+	if len(d.values) == 0 {
+		return "", "", fmt.Errorf("css: no value for property %q", d.property)
+	}
+	values := make([]string, len(d.values))
+	for i, v := range d.values {
+		if v == nil {
+			return "", "", fmt.Errorf("css: nil value for property %q", d.property)
+		}
+		s, err := v.css()
+		if err != nil {
+			return "", "", fmt.Errorf("css: property %q: %v", d.property, err)
+		}
+		values[i] = s
+	}
+	return d.property, strings.Join(values, " "), nil
+}
+
+// Validate returns the first error of the declarations
+func Validate(decls ...Declaration) error {
+	for _, d := range decls {
+		if _, err := d.Render(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Declarations renders the valid declarations, separated by semicolons.
+// Invalid declarations are dropped, use Validate to detect them.
+func Declarations(decls ...Declaration) template.CSS {
+	rendered := []string{}
+	for _, d := range decls {
+		if s, err := d.Render(); err == nil {
+			rendered = append(rendered, s)
+		}
+	}
+	return template.CSS(strings.Join(rendered, ";"))
+}
+
+// Style builds a style attribute from declarations. Invalid declarations
+// are dropped, use Validate to detect them. When an element has several
+// style attributes, their declarations are merged.
+func Style(decls ...Declaration) a.Attribute {
+	return a.Style(Declarations(decls...))
+}
+
+// isProperty reports whether s is a property name, i.e. a CSS identifier,
+// optionally with a vendor prefix, or a custom property
+func isProperty(s string) bool {
+	if strings.HasPrefix(s, "--") {
+		return len(s) > 2 && isNameChars(s[2:])
+	}
+	name := strings.TrimPrefix(s, "-")
+	return name != "" && !(name[0] >= '0' && name[0] <= '9') && name[0] != '-' && isNameChars(name)
+}
+
+func isNameChars(s string) bool {
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
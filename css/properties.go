@@ -0,0 +1,404 @@
+package css
+
+// Begin of property setters
+
+// Properties taking a keyword
+
+func AlignContent(k Keyword) Declaration {
+	return Prop("align-content", k)
+}
+
+func AlignItems(k Keyword) Declaration {
+	return Prop("align-items", k)
+}
+
+func AlignSelf(k Keyword) Declaration {
+	return Prop("align-self", k)
+}
+
+func BorderCollapse(k Keyword) Declaration {
+	return Prop("border-collapse", k)
+}
+
+func BorderStyle(k Keyword) Declaration {
+	return Prop("border-style", k)
+}
+
+func BoxSizing(k Keyword) Declaration {
+	return Prop("box-sizing", k)
+}
+
+func Clear(k Keyword) Declaration {
+	return Prop("clear", k)
+}
+
+func Cursor(k Keyword) Declaration {
+	return Prop("cursor", k)
+}
+
+func Display(k Keyword) Declaration {
+	return Prop("display", k)
+}
+
+func FlexDirection(k Keyword) Declaration {
+	return Prop("flex-direction", k)
+}
+
+func FlexWrap(k Keyword) Declaration {
+	return Prop("flex-wrap", k)
+}
+
+func Float(k Keyword) Declaration {
+	return Prop("float", k)
+}
+
+func FontStyle(k Keyword) Declaration {
+	return Prop("font-style", k)
+}
+
+func FontWeight(k Keyword) Declaration {
+	return Prop("font-weight", k)
+}
+
+func JustifyContent(k Keyword) Declaration {
+	return Prop("justify-content", k)
+}
+
+func ListStyleType(k Keyword) Declaration {
+	return Prop("list-style-type", k)
+}
+
+func ObjectFit(k Keyword) Declaration {
+	return Prop("object-fit", k)
+}
+
+func Overflow(k Keyword) Declaration {
+	return Prop("overflow", k)
+}
+
+func OverflowX(k Keyword) Declaration {
+	return Prop("overflow-x", k)
+}
+
+func OverflowY(k Keyword) Declaration {
+	return Prop("overflow-y", k)
+}
+
+func PointerEvents(k Keyword) Declaration {
+	return Prop("pointer-events", k)
+}
+
+func Position(k Keyword) Declaration {
+	return Prop("position", k)
+}
+
+func TextAlign(k Keyword) Declaration {
+	return Prop("text-align", k)
+}
+
+func TextTransform(k Keyword) Declaration {
+	return Prop("text-transform", k)
+}
+
+func UserSelect(k Keyword) Declaration {
+	return Prop("user-select", k)
+}
+
+func VerticalAlign(k Keyword) Declaration {
+	return Prop("vertical-align", k)
+}
+
+func Visibility(k Keyword) Declaration {
+	return Prop("visibility", k)
+}
+
+func WhiteSpace(k Keyword) Declaration {
+	return Prop("white-space", k)
+}
+
+func WordBreak(k Keyword) Declaration {
+	return Prop("word-break", k)
+}
+
+// Properties taking one or more values, separated by spaces. The flex
+// shorthand is omitted in favour of the Flex keyword, use Prop("flex", ...).
+
+func Animation(values ...Value) Declaration {
+	return Prop("animation", values...)
+}
+
+func AspectRatio(values ...Value) Declaration {
+	return Prop("aspect-ratio", values...)
+}
+
+func Background(values ...Value) Declaration {
+	return Prop("background", values...)
+}
+
+func BackgroundColor(values ...Value) Declaration {
+	return Prop("background-color", values...)
+}
+
+func BackgroundImage(values ...Value) Declaration {
+	return Prop("background-image", values...)
+}
+
+func BackgroundPosition(values ...Value) Declaration {
+	return Prop("background-position", values...)
+}
+
+func BackgroundRepeat(values ...Value) Declaration {
+	return Prop("background-repeat", values...)
+}
+
+func BackgroundSize(values ...Value) Declaration {
+	return Prop("background-size", values...)
+}
+
+func Border(values ...Value) Declaration {
+	return Prop("border", values...)
+}
+
+func BorderBottom(values ...Value) Declaration {
+	return Prop("border-bottom", values...)
+}
+
+func BorderColor(values ...Value) Declaration {
+	return Prop("border-color", values...)
+}
+
+func BorderLeft(values ...Value) Declaration {
+	return Prop("border-left", values...)
+}
+
+func BorderRadius(values ...Value) Declaration {
+	return Prop("border-radius", values...)
+}
+
+func BorderRight(values ...Value) Declaration {
+	return Prop("border-right", values...)
+}
+
+func BorderTop(values ...Value) Declaration {
+	return Prop("border-top", values...)
+}
+
+func BorderWidth(values ...Value) Declaration {
+	return Prop("border-width", values...)
+}
+
+func Bottom(values ...Value) Declaration {
+	return Prop("bottom", values...)
+}
+
+func BoxShadow(values ...Value) Declaration {
+	return Prop("box-shadow", values...)
+}
+
+func Color(values ...Value) Declaration {
+	return Prop("color", values...)
+}
+
+func ColumnGap(values ...Value) Declaration {
+	return Prop("column-gap", values...)
+}
+
+func Content(values ...Value) Declaration {
+	return Prop("content", values...)
+}
+
+func Fill(values ...Value) Declaration {
+	return Prop("fill", values...)
+}
+
+func FlexBasis(values ...Value) Declaration {
+	return Prop("flex-basis", values...)
+}
+
+func FlexGrow(values ...Value) Declaration {
+	return Prop("flex-grow", values...)
+}
+
+func FlexShrink(values ...Value) Declaration {
+	return Prop("flex-shrink", values...)
+}
+
+func Font(values ...Value) Declaration {
+	return Prop("font", values...)
+}
+
+func FontFamily(values ...Value) Declaration {
+	return Prop("font-family", values...)
+}
+
+func FontSize(values ...Value) Declaration {
+	return Prop("font-size", values...)
+}
+
+func Gap(values ...Value) Declaration {
+	return Prop("gap", values...)
+}
+
+func GridArea(values ...Value) Declaration {
+	return Prop("grid-area", values...)
+}
+
+func GridColumn(values ...Value) Declaration {
+	return Prop("grid-column", values...)
+}
+
+func GridRow(values ...Value) Declaration {
+	return Prop("grid-row", values...)
+}
+
+func GridTemplateColumns(values ...Value) Declaration {
+	return Prop("grid-template-columns", values...)
+}
+
+func GridTemplateRows(values ...Value) Declaration {
+	return Prop("grid-template-rows", values...)
+}
+
+func Height(values ...Value) Declaration {
+	return Prop("height", values...)
+}
+
+func Inset(values ...Value) Declaration {
+	return Prop("inset", values...)
+}
+
+func Left(values ...Value) Declaration {
+	return Prop("left", values...)
+}
+
+func LetterSpacing(values ...Value) Declaration {
+	return Prop("letter-spacing", values...)
+}
+
+func LineHeight(values ...Value) Declaration {
+	return Prop("line-height", values...)
+}
+
+func Margin(values ...Value) Declaration {
+	return Prop("margin", values...)
+}
+
+func MarginBottom(values ...Value) Declaration {
+	return Prop("margin-bottom", values...)
+}
+
+func MarginLeft(values ...Value) Declaration {
+	return Prop("margin-left", values...)
+}
+
+func MarginRight(values ...Value) Declaration {
+	return Prop("margin-right", values...)
+}
+
+func MarginTop(values ...Value) Declaration {
+	return Prop("margin-top", values...)
+}
+
+func MaxHeight(values ...Value) Declaration {
+	return Prop("max-height", values...)
+}
+
+func MaxWidth(values ...Value) Declaration {
+	return Prop("max-width", values...)
+}
+
+func MinHeight(values ...Value) Declaration {
+	return Prop("min-height", values...)
+}
+
+func MinWidth(values ...Value) Declaration {
+	return Prop("min-width", values...)
+}
+
+func Opacity(values ...Value) Declaration {
+	return Prop("opacity", values...)
+}
+
+func Order(values ...Value) Declaration {
+	return Prop("order", values...)
+}
+
+func Outline(values ...Value) Declaration {
+	return Prop("outline", values...)
+}
+
+func OutlineOffset(values ...Value) Declaration {
+	return Prop("outline-offset", values...)
+}
+
+func Padding(values ...Value) Declaration {
+	return Prop("padding", values...)
+}
+
+func PaddingBottom(values ...Value) Declaration {
+	return Prop("padding-bottom", values...)
+}
+
+func PaddingLeft(values ...Value) Declaration {
+	return Prop("padding-left", values...)
+}
+
+func PaddingRight(values ...Value) Declaration {
+	return Prop("padding-right", values...)
+}
+
+func PaddingTop(values ...Value) Declaration {
+	return Prop("padding-top", values...)
+}
+
+func Right(values ...Value) Declaration {
+	return Prop("right", values...)
+}
+
+func RowGap(values ...Value) Declaration {
+	return Prop("row-gap", values...)
+}
+
+func Stroke(values ...Value) Declaration {
+	return Prop("stroke", values...)
+}
+
+func StrokeWidth(values ...Value) Declaration {
+	return Prop("stroke-width", values...)
+}
+
+func TextDecoration(values ...Value) Declaration {
+	return Prop("text-decoration", values...)
+}
+
+func TextIndent(values ...Value) Declaration {
+	return Prop("text-indent", values...)
+}
+
+func TextOverflow(values ...Value) Declaration {
+	return Prop("text-overflow", values...)
+}
+
+func Top(values ...Value) Declaration {
+	return Prop("top", values...)
+}
+
+func Transform(values ...Value) Declaration {
+	return Prop("transform", values...)
+}
+
+func TransformOrigin(values ...Value) Declaration {
+	return Prop("transform-origin", values...)
+}
+
+func Transition(values ...Value) Declaration {
+	return Prop("transition", values...)
+}
+
+func Width(values ...Value) Declaration {
+	return Prop("width", values...)
+}
+
+func ZIndex(values ...Value) Declaration {
+	return Prop("z-index", values...)
+}
@@ -0,0 +1,331 @@
+package css
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"strings"
+)
+
+// Item is a rule or an at-rule of a stylesheet
+type Item interface {
+	render(indent string) (string, error)
+	scope(scope Selector) Item
+}
+
+// Selector is a CSS selector. Its methods append pseudo-classes, pseudo-
+// elements and combinators:
+//
+//	css.Sel(".btn").Hover()                      // .btn:hover
+//	css.Sel(".list").Child("li").Not(".active") // .list > li:not(.active)
+type Selector string
+
+func Sel(s string) Selector {
+	return Selector(s)
+}
+
+// Pseudo appends a pseudo-class, e.g. Pseudo("nth-child(2n)")
+func (s Selector) Pseudo(class string) Selector {
+	return s + Selector(":"+class)
+}
+
+// PseudoElement appends a pseudo-element, e.g. PseudoElement("marker")
+func (s Selector) PseudoElement(element string) Selector {
+	return s + Selector("::"+element)
+}
+
+func (s Selector) Hover() Selector        { return s.Pseudo("hover") }
+func (s Selector) Focus() Selector        { return s.Pseudo("focus") }
+func (s Selector) FocusVisible() Selector { return s.Pseudo("focus-visible") }
+func (s Selector) FocusWithin() Selector  { return s.Pseudo("focus-within") }
+func (s Selector) Active() Selector       { return s.Pseudo("active") }
+func (s Selector) Visited() Selector      { return s.Pseudo("visited") }
+func (s Selector) Disabled() Selector     { return s.Pseudo("disabled") }
+func (s Selector) Checked() Selector      { return s.Pseudo("checked") }
+func (s Selector) FirstChild() Selector   { return s.Pseudo("first-child") }
+func (s Selector) LastChild() Selector    { return s.Pseudo("last-child") }
+func (s Selector) Before() Selector       { return s.PseudoElement("before") }
+func (s Selector) After() Selector        { return s.PseudoElement("after") }
+
+func (s Selector) NthChild(n string) Selector {
+	return s.Pseudo("nth-child(" + n + ")")
+}
+
+func (s Selector) Not(other Selector) Selector {
+	return s.Pseudo("not(" + string(other) + ")")
+}
+
+func (s Selector) Has(other Selector) Selector {
+	return s.Pseudo("has(" + string(other) + ")")
+}
+
+// Child appends a child combinator, s > other
+func (s Selector) Child(other Selector) Selector {
+	return s + " > " + other
+}
+
+// Descendant appends a descendant combinator, s other
+func (s Selector) Descendant(other Selector) Selector {
+	return s + " " + other
+}
+
+// Or groups selectors, s, other
+func (s Selector) Or(other Selector) Selector {
+	return s + ", " + other
+}
+
+// Scope restricts each selector of a group to descendants of scope. A
+// selector containing & refers to the scope itself instead, e.g. &:hover.
+//
+//	css.Sel(".title, &.active").Scope(".card") // .card .title, .card.active
+func (s Selector) Scope(scope Selector) Selector {
+	parts := []string{}
+	depth, start := 0, 0
+	for i, c := range s + "," {
+		switch c {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(string(s[start:i])))
+				start = i + 1
+			}
+		}
+	}
+	for i, p := range parts {
+		if strings.Contains(p, "&") {
+			parts[i] = strings.ReplaceAll(p, "&", string(scope))
+		} else {
+			parts[i] = string(scope) + " " + p
+		}
+	}
+	return Selector(strings.Join(parts, ", "))
+}
+
+type rule struct {
+	selector Selector
+	decls    []Declaration
+}
+
+// Rule creates a style rule applying the declarations to the selector
+func Rule(selector Selector, decls ...Declaration) Item {
+	return rule{selector, decls}
+}
+
+func (r rule) render(indent string) (string, error) {
+	if err := checkPrelude(string(r.selector)); err != nil {
+		return "", fmt.Errorf("css: selector %q: %v", r.selector, err)
+	}
+	return renderBlock(indent, string(r.selector), r.decls)
+}
+
+func (r rule) scope(scope Selector) Item {
+	return rule{r.selector.Scope(scope), r.decls}
+}
+
+func renderBlock(indent, prelude string, decls []Declaration) (string, error) {
+	s := indent + prelude + " {\n"
+	for _, d := range decls {
+		property, value, err := d.render()
+		if err != nil {
+			return "", err
+		}
+		s += indent + "  " + property + ": " + value + ";\n"
+	}
+	return s + indent + "}\n", nil
+}
+
+type atRule struct {
+	name    string
+	prelude string
+	items   []Item
+}
+
+// Media creates a @media rule, e.g. Media("(min-width: 600px)", rules...)
+func Media(query string, items ...Item) Item {
+	return atRule{"media", query, items}
+}
+
+// Container creates a @container rule. The query may start with the name
+// of the container, e.g. Container("sidebar (min-width: 400px)", rules...)
+func Container(query string, items ...Item) Item {
+	return atRule{"container", query, items}
+}
+
+// Supports creates a @supports rule, e.g. Supports("(display: grid)", rules...)
+func Supports(condition string, items ...Item) Item {
+	return atRule{"supports", condition, items}
+}
+
+func (r atRule) scope(scope Selector) Item {
+	items := make([]Item, len(r.items))
+	for i, item := range r.items {
+		items[i] = item.scope(scope)
+	}
+	return atRule{r.name, r.prelude, items}
+}
+
+func (r atRule) render(indent string) (string, error) {
+	if err := checkPrelude(r.prelude); err != nil {
+		return "", fmt.Errorf("css: @%s %q: %v", r.name, r.prelude, err)
+	}
+	s := indent + "@" + r.name + " " + r.prelude + " {\n"
+	for _, item := range r.items {
+		rendered, err := item.render(indent + "  ")
+		if err != nil {
+			return "", err
+		}
+		s += rendered
+	}
+	return s + indent + "}\n", nil
+}
+
+// Keyframe is a step of a @keyframes rule
+type Keyframe struct {
+	selector string
+	decls    []Declaration
+}
+
+// From is the first keyframe, equal to At(0)
+func From(decls ...Declaration) Keyframe {
+	return Keyframe{"from", decls}
+}
+
+// To is the last keyframe, equal to At(100)
+func To(decls ...Declaration) Keyframe {
+	return Keyframe{"to", decls}
+}
+
+// At is the keyframe at the given percentage of the animation
+func At(percent float64, decls ...Declaration) Keyframe {
+	return Keyframe{formatNumber(percent) + "%", decls}
+}
+
+type keyframes struct {
+	name   string
+	frames []Keyframe
+}
+
+// Keyframes creates a @keyframes rule, naming an animation
+func Keyframes(name string, frames ...Keyframe) Item {
+	return keyframes{name, frames}
+}
+
+// Keyframes are global, so their names are not scoped
+func (k keyframes) scope(Selector) Item {
+	return k
+}
+
+func (k keyframes) render(indent string) (string, error) {
+	if !isIdent(k.name) {
+		return "", fmt.Errorf("css: invalid keyframes name %q", k.name)
+	}
+	s := indent + "@keyframes " + k.name + " {\n"
+	for _, f := range k.frames {
+		rendered, err := renderBlock(indent+"  ", f.selector, f.decls)
+		if err != nil {
+			return "", err
+		}
+		s += rendered
+	}
+	return s + indent + "}\n", nil
+}
+
+// checkPrelude rejects selectors and queries which could end the rule, the
+// stylesheet or the style element early
+func checkPrelude(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return fmt.Errorf("empty")
+	}
+	if i := strings.IndexAny(s, "{};<@\\"); i >= 0 {
+		return fmt.Errorf("unsafe character %q", s[i])
+	}
+	if strings.Contains(s, "/*") {
+		return fmt.Errorf("unsafe sequence /*")
+	}
+	return nil
+}
+
+// Sheet is a stylesheet defined in Go. It can be rendered into a style
+// element using htmlgo's Stylesheet, or into a .css file:
+//
+//	Style_(Stylesheet(sheet.CSS()))
+type Sheet struct {
+	items []Item
+}
+
+func NewSheet(items ...Item) Sheet {
+	return Sheet{items}
+}
+
+// Add appends items to the stylesheet
+func (s *Sheet) Add(items ...Item) {
+	s.items = append(s.items, items...)
+}
+
+// Items returns the rules and at-rules of the stylesheet
+func (s Sheet) Items() []Item {
+	return append([]Item(nil), s.items...)
+}
+
+// Scope restricts all rules of the stylesheet to descendants of scope, see
+// Selector.Scope. Names of keyframes are not changed.
+func (s Sheet) Scope(scope Selector) Sheet {
+	items := make([]Item, len(s.items))
+	for i, item := range s.items {
+		items[i] = item.scope(scope)
+	}
+	return Sheet{items}
+}
+
+// Render returns the stylesheet, or the first error of its rules
+func (s Sheet) Render() (string, error) {
+	out := ""
+	for _, item := range s.items {
+		rendered, err := item.render("")
+		if err != nil {
+			return "", err
+		}
+		out += rendered
+	}
+	return out, nil
+}
+
+// CSS renders the valid rules of the stylesheet. Invalid rules are
+// dropped, use Render to detect them.
+func (s Sheet) CSS() template.CSS {
+	out := ""
+	for _, item := range s.items {
+		if rendered, err := item.render(""); err == nil {
+			out += rendered
+		}
+	}
+	return template.CSS(strings.TrimSuffix(out, "\n"))
+}
+
+func (s Sheet) String() string {
+	return string(s.CSS())
+}
+
+// WriteTo writes the stylesheet to w, failing on the first invalid rule
+func (s Sheet) WriteTo(w io.Writer) (int64, error) {
+	rendered, err := s.Render()
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.WriteString(w, rendered)
+	return int64(n), err
+}
+
+// WriteFile writes the stylesheet to a .css file, failing on the first
+// invalid rule
+func (s Sheet) WriteFile(path string) error {
+	rendered, err := s.Render()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(rendered), 0644)
+}
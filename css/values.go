@@ -0,0 +1,289 @@
+package css
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Keyword is a CSS identifier such as auto or flex
+type Keyword string
+
+const (
+	Auto         Keyword = "auto"
+	None         Keyword = "none"
+	Normal       Keyword = "normal"
+	Inherit      Keyword = "inherit"
+	Initial      Keyword = "initial"
+	Unset        Keyword = "unset"
+	Block        Keyword = "block"
+	Inline       Keyword = "inline"
+	InlineBlock  Keyword = "inline-block"
+	Flex         Keyword = "flex"
+	InlineFlex   Keyword = "inline-flex"
+	Grid         Keyword = "grid"
+	InlineGrid   Keyword = "inline-grid"
+	Contents     Keyword = "contents"
+	Static       Keyword = "static"
+	Relative     Keyword = "relative"
+	Absolute     Keyword = "absolute"
+	Fixed        Keyword = "fixed"
+	Sticky       Keyword = "sticky"
+	Visible      Keyword = "visible"
+	Hidden       Keyword = "hidden"
+	Scroll       Keyword = "scroll"
+	Row          Keyword = "row"
+	Column       Keyword = "column"
+	Wrap         Keyword = "wrap"
+	NoWrap       Keyword = "nowrap"
+	Center       Keyword = "center"
+	FlexStart    Keyword = "flex-start"
+	FlexEnd      Keyword = "flex-end"
+	SpaceBetween Keyword = "space-between"
+	SpaceAround  Keyword = "space-around"
+	Stretch      Keyword = "stretch"
+	Baseline     Keyword = "baseline"
+	Bold         Keyword = "bold"
+	Italic       Keyword = "italic"
+	Underline    Keyword = "underline"
+	Solid        Keyword = "solid"
+	Dashed       Keyword = "dashed"
+	Dotted       Keyword = "dotted"
+	Pointer      Keyword = "pointer"
+	Transparent  Keyword = "transparent"
+	CurrentColor Keyword = "currentcolor"
+	BorderBox    Keyword = "border-box"
+	ContentBox   Keyword = "content-box"
+)
+
+func (k Keyword) css() (string, error) {
+	if !isIdent(string(k)) {
+		return "", fmt.Errorf("invalid keyword %q", string(k))
+	}
+	return string(k), nil
+}
+
+// Dimension is a number with a unit
+type Dimension struct {
+	n    float64
+	unit string
+}
+
+func (d Dimension) css() (string, error) {
+	return formatNumber(d.n) + d.unit, nil
+}
+
+func Px(n float64) Dimension      { return Dimension{n, "px"} }
+func Em(n float64) Dimension      { return Dimension{n, "em"} }
+func Rem(n float64) Dimension     { return Dimension{n, "rem"} }
+func Ch(n float64) Dimension      { return Dimension{n, "ch"} }
+func Percent(n float64) Dimension { return Dimension{n, "%"} }
+func Vw(n float64) Dimension      { return Dimension{n, "vw"} }
+func Vh(n float64) Dimension      { return Dimension{n, "vh"} }
+func Fr(n float64) Dimension      { return Dimension{n, "fr"} }
+func Deg(n float64) Dimension     { return Dimension{n, "deg"} }
+func Ms(n float64) Dimension      { return Dimension{n, "ms"} }
+func S(n float64) Dimension       { return Dimension{n, "s"} }
+
+// Number is a unitless number, e.g. for line-height or opacity
+func Number(n float64) Dimension { return Dimension{n, ""} }
+
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// ColorValue is a CSS color
+type ColorValue struct {
+	s   string
+	err error
+}
+
+func (c ColorValue) css() (string, error) {
+	return c.s, c.err
+}
+
+// Hex creates a color from a hex notation such as #fff or #ff000080
+func Hex(s string) ColorValue {
+	digits := strings.TrimPrefix(s, "#")
+	switch len(digits) {
+	case 3, 4, 6, 8:
+	default:
+		return ColorValue{err: fmt.Errorf("invalid hex color %q", s)}
+	}
+	for _, c := range digits {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return ColorValue{err: fmt.Errorf("invalid hex color %q", s)}
+		}
+	}
+	return ColorValue{s: "#" + digits}
+}
+
+func RGB(r, g, b uint8) ColorValue {
+	return ColorValue{s: fmt.Sprintf("rgb(%d %d %d)", r, g, b)}
+}
+
+// RGBA creates a color with an alpha value between 0 and 1
+func RGBA(r, g, b uint8, alpha float64) ColorValue {
+	return ColorValue{s: fmt.Sprintf("rgb(%d %d %d / %s)", r, g, b, formatNumber(alpha))}
+}
+
+// HSL creates a color from a hue in degrees and saturation and lightness in
+// percent
+func HSL(h, s, l float64) ColorValue {
+	return ColorValue{s: fmt.Sprintf("hsl(%s %s%% %s%%)", formatNumber(h), formatNumber(s), formatNumber(l))}
+}
+
+// Named creates a color from its name, e.g. rebeccapurple
+func Named(name string) ColorValue {
+	if !isIdent(name) {
+		return ColorValue{err: fmt.Errorf("invalid color name %q", name)}
+	}
+	return ColorValue{s: name}
+}
+
+type function struct {
+	s   string
+	err error
+}
+
+func (f function) css() (string, error) {
+	return f.s, f.err
+}
+
+// Var references a custom property with optional fallback values, e.g.
+// Var("gap", Px(4)) renders var(--gap, 4px)
+func Var(property string, fallback ...Value) Value {
+	name := "--" + strings.TrimPrefix(property, "--")
+	if !isProperty(name) {
+		return function{err: fmt.Errorf("invalid custom property %q", property)}
+	}
+	if len(fallback) == 0 {
+		return function{s: "var(" + name + ")"}
+	}
+	s, err := List(fallback...).css()
+	return function{s: "var(" + name + ", " + s + ")", err: err}
+}
+
+// Func calls a CSS function with comma-separated arguments, e.g.
+// Func("rotate", Deg(45)) or Func("translate", Px(4), Percent(50)). Names
+// rejected by Raw and the functions loading resources, e.g. url and
+// image-set, are invalid, use URL instead.
+func Func(name string, args ...Value) Value {
+	if !isIdent(name) || checkRaw(name) != nil || loadsResource(name) {
+		return function{err: fmt.Errorf("invalid function name %q", name)}
+	}
+	s, err := List(args...).css()
+	return function{s: name + "(" + s + ")", err: err}
+}
+
+// resourceFunctions are the CSS functions whose string arguments are URLs
+var resourceFunctions = map[string]bool{
+	"url":       true,
+	"src":       true,
+	"image":     true,
+	"image-set": true,
+}
+
+// loadsResource reports whether the function of the given name, possibly
+// with a vendor prefix, loads a resource
+func loadsResource(name string) bool {
+	name = strings.ToLower(name)
+	if strings.HasPrefix(name, "-") {
+		if i := strings.Index(name[1:], "-"); i >= 0 {
+			name = name[i+2:]
+		}
+	}
+	return resourceFunctions[name]
+}
+
+// URL references a resource, e.g. URL("/img/bg.png"). Like href attributes
+// in html/template, URLs with a scheme other than http, https or mailto are
+// replaced by #ZgotmplZ.
+func URL(u string) Value {
+	if i := strings.IndexByte(u, ':'); i >= 0 && !strings.ContainsRune(u[:i], '/') {
+		switch strings.ToLower(u[:i]) {
+		case "http", "https", "mailto":
+		default:
+			u = "#ZgotmplZ"
+		}
+	}
+	s, err := String(u).css()
+	return function{s: "url(" + s + ")", err: err}
+}
+
+// List separates values by commas, e.g. for transitions
+func List(values ...Value) Value {
+	rendered := make([]string, len(values))
+	for i, v := range values {
+		if v == nil {
+			return function{err: fmt.Errorf("nil value")}
+		}
+		s, err := v.css()
+		if err != nil {
+			return function{err: err}
+		}
+		rendered[i] = s
+	}
+	return function{s: strings.Join(rendered, ", ")}
+}
+
+// String is a quoted CSS string, e.g. a font family name
+type String string
+
+func (s String) css() (string, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteByte('"')
+	for _, c := range string(s) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9',
+			c == ' ', c == '-', c == '_', c == ',', c == '.', c >= 0x80:
+			buf.WriteRune(c)
+		default:
+			fmt.Fprintf(buf, "\\%x ", c)
+		}
+	}
+	buf.WriteByte('"')
+	return buf.String(), nil
+}
+
+// Raw is an unchecked value. It is rejected if it contains any character or
+// sequence which html/template's CSS escaper neutralises, i.e. quotes,
+// brackets, parentheses, slashes, semicolons, @, backslashes, backticks,
+// angle brackets, -- after the first character, or the identifiers
+// expression and mozbinding.
+type Raw string
+
+func (r Raw) css() (string, error) {
+	if err := checkRaw(string(r)); err != nil {
+		return "", err
+	}
+	return string(r), nil
+}
+
+func checkRaw(s string) error {
+	id := []byte{}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case 0, '"', '\'', '(', ')', '/', ';', '@', '[', '\\', ']', '`', '{', '}', '<', '>':
+			return fmt.Errorf("unsafe character %q in value %q", c, s)
+		case '-':
+			if i != 0 && s[i-1] == '-' {
+				return fmt.Errorf("unsafe sequence -- in value %q", s)
+			}
+		}
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' {
+			id = append(id, c)
+		}
+	}
+	lower := strings.ToLower(string(id))
+	if strings.Contains(lower, "expression") || strings.Contains(lower, "mozbinding") {
+		return fmt.Errorf("unsafe identifier in value %q", s)
+	}
+	return nil
+}
+
+func isIdent(s string) bool {
+	return !strings.HasPrefix(s, "--") && isProperty(s)
+}
@@ -0,0 +1,87 @@
+package css
+
+import (
+	"testing"
+)
+
+func TestValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   Value
+		want    string
+		wantErr bool
+	}{
+		{"keyword", Flex, "flex", false},
+		{"invalid keyword", Keyword("a;b"), "", true},
+		{"dimension", Px(1.5), "1.5px", false},
+		{"hex", Hex("#FFF"), "#FFF", false},
+		{"invalid hex", Hex("#ggg"), "", true},
+		{"rgba", RGBA(0, 0, 0, 0.5), "rgb(0 0 0 / 0.5)", false},
+		{"named", Named("rebeccapurple"), "rebeccapurple", false},
+		{"invalid named", Named("red)"), "", true},
+
+		{"var", Var("gap"), "var(--gap)", false},
+		{"var with dashes", Var("--gap"), "var(--gap)", false},
+		{"var with fallback", Var("gap", Px(4), Rem(1)), "var(--gap, 4px, 1rem)", false},
+		{"invalid var", Var("gap)"), "", true},
+		{"var with invalid fallback", Var("gap", Raw("a;b")), "", true},
+
+		{"func", Func("rotate", Deg(45)), "rotate(45deg)", false},
+		{"func with arguments", Func("translate", Px(4), Percent(50)), "translate(4px, 50%)", false},
+		{"func with invalid argument", Func("rotate", Raw("1)")), "", true},
+		{"func with nil argument", Func("rotate", nil), "", true},
+		{"func expression", Func("expression", Raw("1")), "", true},
+		{"func expression uppercase", Func("EXPRESSION", Raw("1")), "", true},
+		{"func containing expression", Func("my-expression", Raw("1")), "", true},
+		{"func mozbinding", Func("mozbinding"), "", true},
+		{"func url", Func("url", String("/a.png")), "", true},
+		{"func url uppercase", Func("URL", String("/a.png")), "", true},
+		{"func image-set", Func("image-set", String("/a.png")), "", true},
+		{"func prefixed image-set", Func("-webkit-image-set", String("/a.png")), "", true},
+		{"func src", Func("src", String("/a.png")), "", true},
+		{"func quote", Func(`rotate"`), "", true},
+		{"func parenthesis", Func("rotate("), "", true},
+		{"func custom property", Func("--rotate"), "", true},
+
+		{"url", URL("/img/bg.png"), `url("\2f img\2f bg.png")`, false},
+		{"url https", URL("https://a.b/c"), `url("https\3a \2f \2f a.b\2f c")`, false},
+		{"url mailto", URL("MAILTO:a@b.c"), `url("MAILTO\3a a\40 b.c")`, false},
+		{"url javascript", URL("javascript:alert(1)"), `url("\23 ZgotmplZ")`, false},
+		{"url javascript uppercase", URL("JavaScript:alert(1)"), `url("\23 ZgotmplZ")`, false},
+		{"url data", URL("data:image/png;base64,AA"), `url("\23 ZgotmplZ")`, false},
+		{"url relative with colon", URL("/a:b"), `url("\2f a\3a b")`, false},
+		{"url quote", URL(`a")`), `url("a\22 \29 ")`, false},
+
+		{"string", String(`a "b"`), `"a \22 b\22 "`, false},
+		{"list", List(Px(1), Auto), "1px, auto", false},
+		{"list with nil", List(Px(1), nil), "", true},
+
+		{"raw", Raw("1px solid"), "1px solid", false},
+		{"raw quote", Raw(`"a"`), "", true},
+		{"raw parenthesis", Raw("url(a)"), "", true},
+		{"raw slash", Raw("1/2"), "", true},
+		{"raw semicolon", Raw("a;b"), "", true},
+		{"raw at", Raw("@import"), "", true},
+		{"raw backslash", Raw(`\61`), "", true},
+		{"raw angle bracket", Raw("</style>"), "", true},
+		{"raw leading dashes", Raw("--a"), "", true},
+		{"raw dash", Raw("-a-b"), "-a-b", false},
+		{"raw inner dashes", Raw("a--b"), "", true},
+		{"raw expression", Raw("Expression"), "", true},
+		{"raw split expression", Raw("expr ession"), "", true},
+		{"raw mozbinding", Raw("MozBinding"), "", true},
+		{"raw nul", Raw("a\x00"), "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.value.css()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("css() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("css() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
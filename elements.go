@@ -1,1157 +1,1229 @@
 package htmlgo
 
 import (
-    "fmt"
-    "io"
-    "strings"
-    "html"
-    "html/template"
-    "bytes"
-
-    a "github.com/julvo/htmlgo/attributes"
+	"bytes"
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
 )
 
 type HTML string
 
 type JS struct {
-    templ       string
-    data        interface{}
+	templ string
+	data  interface{}
 }
 
+type CSS struct {
+	templ string
+	data  interface{}
+}
+
+// WriteTo writes h to w, reformatted by the DefaultRenderer if it is set
 func WriteTo(w io.Writer, h HTML) {
-    w.Write([]byte(h))
+	if DefaultRenderer != nil {
+		DefaultRenderer.WriteTo(w, h)
+		return
+	}
+	w.Write([]byte(h))
+}
+
+// voidTags lists the void elements, which have no end tag
+var voidTags = map[string]bool{
+	"area":     true,
+	"base":     true,
+	"br":       true,
+	"col":      true,
+	"embed":    true,
+	"hr":       true,
+	"img":      true,
+	"input":    true,
+	"link":     true,
+	"meta":     true,
+	"source":   true,
+	"track":    true,
+	"wbr":      true,
+	"basefont": true,
+	"bgsound":  true,
+	"frame":    true,
+	"isindex":  true,
+	"keygen":   true,
+	"param":    true,
 }
 
 // Build a slice of type []Attribute for cosmetic purposes
 func Attr(attrs ...a.Attribute) []a.Attribute {
-    return attrs
+	return attrs
 }
 
-func prepareAttributes(attrs []a.Attribute) (string, string, map[string]interface{}) {
-    data := map[string]interface{}{}
-    templ := ""
-    defs := ""
-
-    for _, attr := range attrs {
-        data[attr.Name] = attr.Data
-        templ += ` {{template "` + attr.Name + `" .` + attr.Name + `}}`
-        defs += attr.Templ
-    }
-
-    return templ, defs, data
+func renderAttributes(attrs []a.Attribute) string {
+	s := ""
+	for _, attr := range a.Render(attrs) {
+		s += " " + attr.String()
+	}
+	return s
 }
 
 func insertChildren(children ...HTML) string {
-    s := ""
-    for _, c := range children {
-        s += string(c)
-    }
-    return s
+	s := ""
+	for _, c := range children {
+		s += string(c)
+	}
+	return s
 }
 
+// indent indents all lines of s, except within preformatted and escapable
+// raw text elements, whose text is kept as is
 func indent(s, indentation string) string {
-    return strings.Replace(s, "\n", "\n" + indentation, -1)
+	out := ""
+	for {
+		start, end := nextPreformatted(s)
+		if start < 0 {
+			break
+		}
+		out += strings.Replace(s[:start], "\n", "\n"+indentation, -1) + s[start:end]
+		s = s[end:]
+	}
+	return out + strings.Replace(s, "\n", "\n"+indentation, -1)
+}
+
+// startTag produces the start tag of an element, on a new line unless the
+// element is inline, where the line break would render as a space
+func startTag(tag string, attrs []a.Attribute) string {
+	s := "<" + tag + renderAttributes(attrs) + ">"
+	if inlineTags[tag] {
+		return s
+	}
+	return "\n" + s
 }
 
 func buildElement(tag string, attrs []a.Attribute, content string, close_ bool) string {
-    templ, defs, data := prepareAttributes(attrs)
-    complTempl := defs + "\n<" + tag + templ + ">" + content
-    if close_ {
-        complTempl += "\n</" + tag +">"
-    }
-
-    t, _ := template.New(tag).Parse(complTempl)
-
-    buf := new(bytes.Buffer)
-    _ = t.Execute(buf, data)
-    return buf.String()
-}
-
+	s := startTag(tag, attrs) + content
+	if close_ {
+		if strings.Contains(content, "\n") {
+			s += "\n"
+		}
+		s += "</" + tag + ">"
+	}
+	return s
+}
+
+// Element produces an element from its children. Children of block elements
+// are indented, while the children of inline and preformatted elements,
+// e.g. span and pre, are kept as is, as whitespace is significant there.
 func Element(tag string, attrs []a.Attribute, children ...HTML) HTML {
-    return HTML(buildElement(tag, attrs, 
-                indent(insertChildren(children...), "  "), true))
+	content := insertChildren(children...)
+	switch {
+	case preformattedTags[tag]:
+		// the parser drops a line break following the start tag
+		if strings.HasPrefix(content, "\n") {
+			content = "\n" + content
+		}
+		return HTML(startTag(tag, attrs) + content + "</" + tag + ">")
+	case inlineTags[tag]:
+		return HTML(startTag(tag, attrs) + content + "</" + tag + ">")
+	case rawTextTags[tag]:
+		// raw text contains no elements, even if it looks like it does
+		return HTML(buildElement(tag, attrs,
+			strings.Replace(content, "\n", "\n  ", -1), true))
+	}
+	return HTML(buildElement(tag, attrs, indent(content, "  "), true))
 }
 
 func VoidElement(tag string, attrs []a.Attribute) HTML {
-    return HTML(buildElement(tag, attrs, "", false))
+	return HTML(buildElement(tag, attrs, "", false))
+}
+
+// ForeignElement produces an element of SVG or MathML. Unlike HTML elements,
+// foreign elements without children are self-closing, e.g. <circle r="1"/>.
+func ForeignElement(tag string, attrs []a.Attribute, children ...HTML) HTML {
+	content := insertChildren(children...)
+	if content == "" {
+		return HTML("\n<" + tag + renderAttributes(attrs) + "/>")
+	}
+	if !strings.Contains(content, "\n") {
+		return HTML("\n<" + tag + renderAttributes(attrs) + ">" + content + "</" + tag + ">")
+	}
+	return HTML("\n<" + tag + renderAttributes(attrs) + ">" + indent(content, "  ") + "\n</" + tag + ">")
 }
 
 // Produce HTML from plain text by escaping
 func Text(v interface{}) HTML {
-    return HTML("\n" + html.EscapeString(fmt.Sprint(v)))
+	return HTML(html.EscapeString(fmt.Sprint(v)))
 }
 
 func Text_(s string) HTML {
-    return HTML(s)
+	return HTML(s)
+}
+
+// Comment produces an HTML comment. Sequences which would end the comment
+// early, such as -->, are broken up by inserting spaces between hyphens,
+// and text which would read as a marker of HeadOnce or Once is indented.
+func Comment(text string) HTML {
+	return HTML("\n<!--" + escapeComment(text) + "-->")
+}
+
+func escapeComment(s string) string {
+	for strings.Contains(s, "--") {
+		s = strings.Replace(s, "--", "- -", -1)
+	}
+	if strings.HasPrefix(s, ">") || strings.HasPrefix(s, "-") ||
+		strings.HasPrefix(s, "htmlgo:") || strings.HasPrefix(s, "/htmlgo:") {
+		s = " " + s
+	}
+	if strings.HasSuffix(s, "-") {
+		s += " "
+	}
+	return s
+}
+
+// ConditionalComment produces a downlevel-hidden conditional comment, whose
+// children are only rendered by clients evaluating the condition, e.g.
+// Outlook for the condition "mso". Other clients treat the children as a
+// comment, so they must not contain comments themselves. Conditions may
+// consist of letters, digits, spaces, dots and the operators ! ( ) & |,
+// otherwise nothing is rendered.
+func ConditionalComment(condition string, children ...HTML) HTML {
+	if !isCondition(condition) {
+		return ""
+	}
+	return HTML("\n<!--[if " + condition + "]>" +
+		insertChildren(children...) + "\n<![endif]-->")
+}
+
+// RevealedConditionalComment produces a downlevel-revealed conditional
+// comment, whose children are rendered by all clients except those not
+// evaluating the condition, e.g. "!mso" hides them from Outlook
+func RevealedConditionalComment(condition string, children ...HTML) HTML {
+	if !isCondition(condition) {
+		return ""
+	}
+	return HTML("\n<!--[if " + condition + "]><!-->" +
+		insertChildren(children...) + "\n<!--<![endif]-->")
+}
+
+func isCondition(s string) bool {
+	if strings.TrimSpace(s) == "" {
+		return false
+	}
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune(" !()&|.", r):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// CDATA produces a CDATA section, which is only allowed in foreign content,
+// i.e. in SVG and MathML elements. Occurrences of ]]> are split across two
+// sections.
+func CDATA(text string) HTML {
+	return HTML("\n<![CDATA[" +
+		strings.Replace(text, "]]>", "]]]]><![CDATA[>", -1) + "]]>")
 }
 
 // Begin of manually defined elements
 
+// Html5 produces a complete HTML5 document, moving content marked by
+// HeadOnce into the head element, see Collect
 func Html5(attrs []a.Attribute, children ...HTML) HTML {
-    return DoctypeHtml5 + Html(attrs, children...)
+	return Collect(DoctypeHtml5 + Html(attrs, children...))
 }
 
 func Html5_(children ...HTML) HTML {
-    return Html5(Attr(), children...)
+	return Html5(Attr(), children...)
 }
 
 func Doctype(t string) HTML {
-    return HTML("<!DOCTYPE " + t + ">")
+	return HTML("<!DOCTYPE " + t + ">")
 }
 
 const DoctypeHtml5 HTML = "<!DOCTYPE HTML>"
 
-func Script(attrs []a.Attribute, js JS) HTML {
-    if js.data == nil {
-        return Element("script", attrs, HTML("\n" + js.templ))
-    }
+// Build an element with raw text content, i.e. script or style, from a
+// template, which is executed with data using html/template to escape data
+// according to the element's context
+func rawTextElement(tag string, attrs []a.Attribute, templ string, data interface{}) HTML {
+	if data == nil {
+		return Element(tag, attrs, HTML("\n"+templ))
+	}
 
-    complTempl := buildElement("script", attrs,
-                               indent("\n" + js.templ, "  "), true)
-    
-    // TODO set verbosity level to enable logging
-    t, err := template.New("_").Delims("{%$", "$%}").Parse(complTempl)
-    if err != nil {
-        return Element("script", attrs)
-    }
+	// The start tag is part of the template, as its attributes, e.g. the
+	// type of a script, determine the escaping of the contents
+	start := "<" + tag + renderAttributes(attrs) + ">"
+	end := "</" + tag + ">"
 
-    buf := new(bytes.Buffer)
-    err = t.Execute(buf, js.data)
-    if err != nil {
-        return Element("script", attrs)
-    }
+	// TODO set verbosity level to enable logging
+	t, err := template.New("_").Delims("{%$", "$%}").Parse(start + templ + end)
+	if err != nil {
+		return Element(tag, attrs)
+	}
 
-    return HTML(buf.String())
-}
+	buf := new(bytes.Buffer)
+	err = t.Execute(buf, data)
+	if err != nil {
+		return Element(tag, attrs)
+	}
 
-func Script_(js JS) HTML {
-    return Script(Attr(), js)
+	content := strings.TrimSuffix(strings.TrimPrefix(buf.String(), start), end)
+	return Element(tag, attrs, HTML("\n"+content))
 }
 
-func JavaScript(data interface{}, templs ...string) JS {
-    js := JS{ data: data }
-    if len(templs) == 0 {
-        js.templ = "{%$.$%}"
-    } else {
-        js.templ = strings.Replace(
-                     strings.Replace(
-                        strings.Join(templs, "\n"),
-                        "{{", "{%$", -1),
-                     "}}", "$%}", -1)
-    }
-    return js
+// Build an element with escapable raw text content, i.e. textarea or title,
+// which cannot contain elements. The text is written as is, without
+// indentation. Carriage returns are kept as character references, as the
+// parser would normalise them.
+func escapableRawTextElement(tag string, attrs []a.Attribute, text string) HTML {
+	text = strings.Replace(html.EscapeString(text), "\r", "&#13;", -1)
+	if preformattedTags[tag] && strings.HasPrefix(text, "\n") {
+		// the parser drops a line break following the start tag
+		text = "\n" + text
+	}
+	return HTML(startTag(tag, attrs) + text + "</" + tag + ">")
 }
 
-func JavaScript_(templs ...string) JS {
-    return JavaScript(nil, templs...)
+// TextareaText produces a textarea element, whose initial value is text
+func TextareaText(attrs []a.Attribute, text string) HTML {
+	return escapableRawTextElement("textarea", attrs, text)
 }
 
-// Begin of generated elements
-
-
-func A(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("a", attrs, children...)
+func TextareaText_(text string) HTML {
+	return TextareaText(Attr(), text)
 }
 
-func A_(children ...HTML) HTML {
-    return A(Attr(), children...)
+// TitleText produces the title element of a document from plain text
+func TitleText(attrs []a.Attribute, text string) HTML {
+	return escapableRawTextElement("title", attrs, text)
 }
 
-func Abbr(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("abbr", attrs, children...)
+func TitleText_(text string) HTML {
+	return TitleText(Attr(), text)
 }
 
-func Abbr_(children ...HTML) HTML {
-    return Abbr(Attr(), children...)
+// Join templates by newlines, replacing the delimiters {{ and }} by
+// {%$ and $%}, which do not clash with the attribute templates
+func rawTextTemplate(templs []string) string {
+	if len(templs) == 0 {
+		return "{%$.$%}"
+	}
+	return strings.Replace(
+		strings.Replace(
+			strings.Join(templs, "\n"),
+			"{{", "{%$", -1),
+		"}}", "$%}", -1)
 }
 
-func Acronym(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("acronym", attrs, children...)
+func Script(attrs []a.Attribute, js JS) HTML {
+	return rawTextElement("script", attrs, js.templ, js.data)
 }
 
-func Acronym_(children ...HTML) HTML {
-    return Acronym(Attr(), children...)
+func Script_(js JS) HTML {
+	return Script(Attr(), js)
 }
 
-func Address(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("address", attrs, children...)
+func JavaScript(data interface{}, templs ...string) JS {
+	return JS{data: data, templ: rawTextTemplate(templs)}
 }
 
-func Address_(children ...HTML) HTML {
-    return Address(Attr(), children...)
+func JavaScript_(templs ...string) JS {
+	return JavaScript(nil, templs...)
 }
 
-func Applet(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("applet", attrs, children...)
+// Style produces a style element. Unlike children of other elements, the
+// stylesheet is not HTML-escaped, so selectors such as ul > li are kept,
+// while the data of the stylesheet is escaped for CSS.
+func Style(attrs []a.Attribute, css CSS) HTML {
+	return rawTextElement("style", attrs, css.templ, css.data)
 }
 
-func Applet_(children ...HTML) HTML {
-    return Applet(Attr(), children...)
+func Style_(css CSS) HTML {
+	return Style(Attr(), css)
 }
 
-func Article(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("article", attrs, children...)
+// Stylesheet produces the contents of a style element, placing data into
+// the given templates like JavaScript
+func Stylesheet(data interface{}, templs ...string) CSS {
+	return CSS{data: data, templ: rawTextTemplate(templs)}
 }
 
-func Article_(children ...HTML) HTML {
-    return Article(Attr(), children...)
+func Stylesheet_(templs ...string) CSS {
+	return Stylesheet(nil, templs...)
 }
 
-func Aside(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("aside", attrs, children...)
+// Begin of generated elements
+
+func A(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("a", attrs, children...)
 }
 
-func Aside_(children ...HTML) HTML {
-    return Aside(Attr(), children...)
+func A_(children ...HTML) HTML {
+	return A(Attr(), children...)
 }
 
-func Audio(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("audio", attrs, children...)
+func Abbr(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("abbr", attrs, children...)
 }
 
-func Audio_(children ...HTML) HTML {
-    return Audio(Attr(), children...)
+func Abbr_(children ...HTML) HTML {
+	return Abbr(Attr(), children...)
 }
 
-func B(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("b", attrs, children...)
+func Address(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("address", attrs, children...)
 }
 
-func B_(children ...HTML) HTML {
-    return B(Attr(), children...)
+func Address_(children ...HTML) HTML {
+	return Address(Attr(), children...)
 }
 
-func Basefont(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("basefont", attrs, children...)
+func Article(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("article", attrs, children...)
 }
 
-func Basefont_(children ...HTML) HTML {
-    return Basefont(Attr(), children...)
+func Article_(children ...HTML) HTML {
+	return Article(Attr(), children...)
 }
 
-func Bdi(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("bdi", attrs, children...)
+func Aside(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("aside", attrs, children...)
 }
 
-func Bdi_(children ...HTML) HTML {
-    return Bdi(Attr(), children...)
+func Aside_(children ...HTML) HTML {
+	return Aside(Attr(), children...)
 }
 
-func Bdo(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("bdo", attrs, children...)
+func Audio(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("audio", attrs, children...)
 }
 
-func Bdo_(children ...HTML) HTML {
-    return Bdo(Attr(), children...)
+func Audio_(children ...HTML) HTML {
+	return Audio(Attr(), children...)
 }
 
-func Bgsound(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("bgsound", attrs, children...)
+func B(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("b", attrs, children...)
 }
 
-func Bgsound_(children ...HTML) HTML {
-    return Bgsound(Attr(), children...)
+func B_(children ...HTML) HTML {
+	return B(Attr(), children...)
 }
 
-func Big(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("big", attrs, children...)
+func Bdi(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("bdi", attrs, children...)
 }
 
-func Big_(children ...HTML) HTML {
-    return Big(Attr(), children...)
+func Bdi_(children ...HTML) HTML {
+	return Bdi(Attr(), children...)
 }
 
-func Blink(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("blink", attrs, children...)
+func Bdo(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("bdo", attrs, children...)
 }
 
-func Blink_(children ...HTML) HTML {
-    return Blink(Attr(), children...)
+func Bdo_(children ...HTML) HTML {
+	return Bdo(Attr(), children...)
 }
 
 func Blockquote(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("blockquote", attrs, children...)
+	return Element("blockquote", attrs, children...)
 }
 
 func Blockquote_(children ...HTML) HTML {
-    return Blockquote(Attr(), children...)
+	return Blockquote(Attr(), children...)
 }
 
 func Body(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("body", attrs, children...)
+	return Element("body", attrs, children...)
 }
 
 func Body_(children ...HTML) HTML {
-    return Body(Attr(), children...)
+	return Body(Attr(), children...)
 }
 
 func Button(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("button", attrs, children...)
+	return Element("button", attrs, children...)
 }
 
 func Button_(children ...HTML) HTML {
-    return Button(Attr(), children...)
+	return Button(Attr(), children...)
 }
 
 func Canvas(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("canvas", attrs, children...)
+	return Element("canvas", attrs, children...)
 }
 
 func Canvas_(children ...HTML) HTML {
-    return Canvas(Attr(), children...)
+	return Canvas(Attr(), children...)
 }
 
 func Caption(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("caption", attrs, children...)
+	return Element("caption", attrs, children...)
 }
 
 func Caption_(children ...HTML) HTML {
-    return Caption(Attr(), children...)
-}
-
-func Center(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("center", attrs, children...)
-}
-
-func Center_(children ...HTML) HTML {
-    return Center(Attr(), children...)
+	return Caption(Attr(), children...)
 }
 
 func Cite(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("cite", attrs, children...)
+	return Element("cite", attrs, children...)
 }
 
 func Cite_(children ...HTML) HTML {
-    return Cite(Attr(), children...)
+	return Cite(Attr(), children...)
 }
 
 func Code(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("code", attrs, children...)
+	return Element("code", attrs, children...)
 }
 
 func Code_(children ...HTML) HTML {
-    return Code(Attr(), children...)
+	return Code(Attr(), children...)
 }
 
 func Colgroup(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("colgroup", attrs, children...)
+	return Element("colgroup", attrs, children...)
 }
 
 func Colgroup_(children ...HTML) HTML {
-    return Colgroup(Attr(), children...)
+	return Colgroup(Attr(), children...)
+}
+
+func Data(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("data", attrs, children...)
+}
+
+func Data_(children ...HTML) HTML {
+	return Data(Attr(), children...)
 }
 
 func Datalist(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("datalist", attrs, children...)
+	return Element("datalist", attrs, children...)
 }
 
 func Datalist_(children ...HTML) HTML {
-    return Datalist(Attr(), children...)
+	return Datalist(Attr(), children...)
 }
 
 func Dd(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("dd", attrs, children...)
+	return Element("dd", attrs, children...)
 }
 
 func Dd_(children ...HTML) HTML {
-    return Dd(Attr(), children...)
+	return Dd(Attr(), children...)
 }
 
 func Del(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("del", attrs, children...)
+	return Element("del", attrs, children...)
 }
 
 func Del_(children ...HTML) HTML {
-    return Del(Attr(), children...)
+	return Del(Attr(), children...)
 }
 
 func Details(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("details", attrs, children...)
+	return Element("details", attrs, children...)
 }
 
 func Details_(children ...HTML) HTML {
-    return Details(Attr(), children...)
+	return Details(Attr(), children...)
 }
 
 func Dfn(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("dfn", attrs, children...)
+	return Element("dfn", attrs, children...)
 }
 
 func Dfn_(children ...HTML) HTML {
-    return Dfn(Attr(), children...)
+	return Dfn(Attr(), children...)
 }
 
-func Dir(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("dir", attrs, children...)
+func Dialog(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("dialog", attrs, children...)
 }
 
-func Dir_(children ...HTML) HTML {
-    return Dir(Attr(), children...)
+func Dialog_(children ...HTML) HTML {
+	return Dialog(Attr(), children...)
 }
 
 func Div(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("div", attrs, children...)
+	return Element("div", attrs, children...)
 }
 
 func Div_(children ...HTML) HTML {
-    return Div(Attr(), children...)
+	return Div(Attr(), children...)
 }
 
 func Dl(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("dl", attrs, children...)
+	return Element("dl", attrs, children...)
 }
 
 func Dl_(children ...HTML) HTML {
-    return Dl(Attr(), children...)
+	return Dl(Attr(), children...)
 }
 
 func Dt(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("dt", attrs, children...)
+	return Element("dt", attrs, children...)
 }
 
 func Dt_(children ...HTML) HTML {
-    return Dt(Attr(), children...)
+	return Dt(Attr(), children...)
 }
 
 func Em(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("em", attrs, children...)
+	return Element("em", attrs, children...)
 }
 
 func Em_(children ...HTML) HTML {
-    return Em(Attr(), children...)
+	return Em(Attr(), children...)
 }
 
 func Fieldset(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("fieldset", attrs, children...)
+	return Element("fieldset", attrs, children...)
 }
 
 func Fieldset_(children ...HTML) HTML {
-    return Fieldset(Attr(), children...)
+	return Fieldset(Attr(), children...)
 }
 
 func Figcaption(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("figcaption", attrs, children...)
+	return Element("figcaption", attrs, children...)
 }
 
 func Figcaption_(children ...HTML) HTML {
-    return Figcaption(Attr(), children...)
+	return Figcaption(Attr(), children...)
 }
 
 func Figure(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("figure", attrs, children...)
+	return Element("figure", attrs, children...)
 }
 
 func Figure_(children ...HTML) HTML {
-    return Figure(Attr(), children...)
-}
-
-func Font(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("font", attrs, children...)
-}
-
-func Font_(children ...HTML) HTML {
-    return Font(Attr(), children...)
+	return Figure(Attr(), children...)
 }
 
 func Footer(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("footer", attrs, children...)
+	return Element("footer", attrs, children...)
 }
 
 func Footer_(children ...HTML) HTML {
-    return Footer(Attr(), children...)
+	return Footer(Attr(), children...)
 }
 
 func Form(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("form", attrs, children...)
+	return Element("form", attrs, children...)
 }
 
 func Form_(children ...HTML) HTML {
-    return Form(Attr(), children...)
-}
-
-func Frame(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("frame", attrs, children...)
-}
-
-func Frame_(children ...HTML) HTML {
-    return Frame(Attr(), children...)
-}
-
-func Frameset(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("frameset", attrs, children...)
-}
-
-func Frameset_(children ...HTML) HTML {
-    return Frameset(Attr(), children...)
+	return Form(Attr(), children...)
 }
 
 func H1(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("h1", attrs, children...)
+	return Element("h1", attrs, children...)
 }
 
 func H1_(children ...HTML) HTML {
-    return H1(Attr(), children...)
+	return H1(Attr(), children...)
 }
 
 func H2(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("h2", attrs, children...)
+	return Element("h2", attrs, children...)
 }
 
 func H2_(children ...HTML) HTML {
-    return H2(Attr(), children...)
+	return H2(Attr(), children...)
 }
 
 func H3(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("h3", attrs, children...)
+	return Element("h3", attrs, children...)
 }
 
 func H3_(children ...HTML) HTML {
-    return H3(Attr(), children...)
+	return H3(Attr(), children...)
 }
 
 func H4(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("h4", attrs, children...)
+	return Element("h4", attrs, children...)
 }
 
 func H4_(children ...HTML) HTML {
-    return H4(Attr(), children...)
+	return H4(Attr(), children...)
 }
 
 func H5(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("h5", attrs, children...)
+	return Element("h5", attrs, children...)
 }
 
 func H5_(children ...HTML) HTML {
-    return H5(Attr(), children...)
+	return H5(Attr(), children...)
 }
 
 func H6(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("h6", attrs, children...)
+	return Element("h6", attrs, children...)
 }
 
 func H6_(children ...HTML) HTML {
-    return H6(Attr(), children...)
+	return H6(Attr(), children...)
 }
 
 func Head(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("head", attrs, children...)
+	return Element("head", attrs, children...)
 }
 
 func Head_(children ...HTML) HTML {
-    return Head(Attr(), children...)
+	return Head(Attr(), children...)
 }
 
 func Header(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("header", attrs, children...)
+	return Element("header", attrs, children...)
 }
 
 func Header_(children ...HTML) HTML {
-    return Header(Attr(), children...)
+	return Header(Attr(), children...)
 }
 
 func Hgroup(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("hgroup", attrs, children...)
+	return Element("hgroup", attrs, children...)
 }
 
 func Hgroup_(children ...HTML) HTML {
-    return Hgroup(Attr(), children...)
+	return Hgroup(Attr(), children...)
 }
 
 func Html(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("html", attrs, children...)
+	return Element("html", attrs, children...)
 }
 
 func Html_(children ...HTML) HTML {
-    return Html(Attr(), children...)
+	return Html(Attr(), children...)
 }
 
 func I(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("i", attrs, children...)
+	return Element("i", attrs, children...)
 }
 
 func I_(children ...HTML) HTML {
-    return I(Attr(), children...)
+	return I(Attr(), children...)
 }
 
 func Iframe(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("iframe", attrs, children...)
+	return Element("iframe", attrs, children...)
 }
 
 func Iframe_(children ...HTML) HTML {
-    return Iframe(Attr(), children...)
+	return Iframe(Attr(), children...)
 }
 
 func Ins(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("ins", attrs, children...)
+	return Element("ins", attrs, children...)
 }
 
 func Ins_(children ...HTML) HTML {
-    return Ins(Attr(), children...)
-}
-
-func Isindex(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("isindex", attrs, children...)
-}
-
-func Isindex_(children ...HTML) HTML {
-    return Isindex(Attr(), children...)
+	return Ins(Attr(), children...)
 }
 
 func Kbd(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("kbd", attrs, children...)
+	return Element("kbd", attrs, children...)
 }
 
 func Kbd_(children ...HTML) HTML {
-    return Kbd(Attr(), children...)
-}
-
-func Keygen(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("keygen", attrs, children...)
-}
-
-func Keygen_(children ...HTML) HTML {
-    return Keygen(Attr(), children...)
+	return Kbd(Attr(), children...)
 }
 
 func Label(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("label", attrs, children...)
+	return Element("label", attrs, children...)
 }
 
 func Label_(children ...HTML) HTML {
-    return Label(Attr(), children...)
+	return Label(Attr(), children...)
 }
 
 func Legend(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("legend", attrs, children...)
+	return Element("legend", attrs, children...)
 }
 
 func Legend_(children ...HTML) HTML {
-    return Legend(Attr(), children...)
+	return Legend(Attr(), children...)
 }
 
 func Li(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("li", attrs, children...)
+	return Element("li", attrs, children...)
 }
 
 func Li_(children ...HTML) HTML {
-    return Li(Attr(), children...)
-}
-
-func Listing(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("listing", attrs, children...)
-}
-
-func Listing_(children ...HTML) HTML {
-    return Listing(Attr(), children...)
+	return Li(Attr(), children...)
 }
 
 func Main(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("main", attrs, children...)
+	return Element("main", attrs, children...)
 }
 
 func Main_(children ...HTML) HTML {
-    return Main(Attr(), children...)
+	return Main(Attr(), children...)
 }
 
 func Map(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("map", attrs, children...)
+	return Element("map", attrs, children...)
 }
 
 func Map_(children ...HTML) HTML {
-    return Map(Attr(), children...)
+	return Map(Attr(), children...)
 }
 
 func Mark(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("mark", attrs, children...)
+	return Element("mark", attrs, children...)
 }
 
 func Mark_(children ...HTML) HTML {
-    return Mark(Attr(), children...)
-}
-
-func Marquee(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("marquee", attrs, children...)
-}
-
-func Marquee_(children ...HTML) HTML {
-    return Marquee(Attr(), children...)
+	return Mark(Attr(), children...)
 }
 
 func Menu(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("menu", attrs, children...)
+	return Element("menu", attrs, children...)
 }
 
 func Menu_(children ...HTML) HTML {
-    return Menu(Attr(), children...)
+	return Menu(Attr(), children...)
 }
 
 func Meter(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("meter", attrs, children...)
+	return Element("meter", attrs, children...)
 }
 
 func Meter_(children ...HTML) HTML {
-    return Meter(Attr(), children...)
+	return Meter(Attr(), children...)
 }
 
 func Nav(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("nav", attrs, children...)
+	return Element("nav", attrs, children...)
 }
 
 func Nav_(children ...HTML) HTML {
-    return Nav(Attr(), children...)
-}
-
-func Nobr(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("nobr", attrs, children...)
-}
-
-func Nobr_(children ...HTML) HTML {
-    return Nobr(Attr(), children...)
-}
-
-func Noframes(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("noframes", attrs, children...)
-}
-
-func Noframes_(children ...HTML) HTML {
-    return Noframes(Attr(), children...)
+	return Nav(Attr(), children...)
 }
 
 func Noscript(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("noscript", attrs, children...)
+	return Element("noscript", attrs, children...)
 }
 
 func Noscript_(children ...HTML) HTML {
-    return Noscript(Attr(), children...)
+	return Noscript(Attr(), children...)
 }
 
 func Object(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("object", attrs, children...)
+	return Element("object", attrs, children...)
 }
 
 func Object_(children ...HTML) HTML {
-    return Object(Attr(), children...)
+	return Object(Attr(), children...)
 }
 
 func Ol(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("ol", attrs, children...)
+	return Element("ol", attrs, children...)
 }
 
 func Ol_(children ...HTML) HTML {
-    return Ol(Attr(), children...)
+	return Ol(Attr(), children...)
 }
 
 func Optgroup(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("optgroup", attrs, children...)
+	return Element("optgroup", attrs, children...)
 }
 
 func Optgroup_(children ...HTML) HTML {
-    return Optgroup(Attr(), children...)
+	return Optgroup(Attr(), children...)
 }
 
 func Option(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("option", attrs, children...)
+	return Element("option", attrs, children...)
 }
 
 func Option_(children ...HTML) HTML {
-    return Option(Attr(), children...)
+	return Option(Attr(), children...)
 }
 
 func Output(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("output", attrs, children...)
+	return Element("output", attrs, children...)
 }
 
 func Output_(children ...HTML) HTML {
-    return Output(Attr(), children...)
+	return Output(Attr(), children...)
 }
 
 func P(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("p", attrs, children...)
+	return Element("p", attrs, children...)
 }
 
 func P_(children ...HTML) HTML {
-    return P(Attr(), children...)
+	return P(Attr(), children...)
 }
 
-func Plaintext(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("plaintext", attrs, children...)
+func Picture(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("picture", attrs, children...)
 }
 
-func Plaintext_(children ...HTML) HTML {
-    return Plaintext(Attr(), children...)
+func Picture_(children ...HTML) HTML {
+	return Picture(Attr(), children...)
 }
 
 func Pre(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("pre", attrs, children...)
+	return Element("pre", attrs, children...)
 }
 
 func Pre_(children ...HTML) HTML {
-    return Pre(Attr(), children...)
+	return Pre(Attr(), children...)
 }
 
 func Progress(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("progress", attrs, children...)
+	return Element("progress", attrs, children...)
 }
 
 func Progress_(children ...HTML) HTML {
-    return Progress(Attr(), children...)
+	return Progress(Attr(), children...)
 }
 
 func Q(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("q", attrs, children...)
+	return Element("q", attrs, children...)
 }
 
 func Q_(children ...HTML) HTML {
-    return Q(Attr(), children...)
+	return Q(Attr(), children...)
 }
 
 func Rp(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("rp", attrs, children...)
+	return Element("rp", attrs, children...)
 }
 
 func Rp_(children ...HTML) HTML {
-    return Rp(Attr(), children...)
+	return Rp(Attr(), children...)
 }
 
 func Rt(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("rt", attrs, children...)
+	return Element("rt", attrs, children...)
 }
 
 func Rt_(children ...HTML) HTML {
-    return Rt(Attr(), children...)
+	return Rt(Attr(), children...)
 }
 
 func Ruby(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("ruby", attrs, children...)
+	return Element("ruby", attrs, children...)
 }
 
 func Ruby_(children ...HTML) HTML {
-    return Ruby(Attr(), children...)
+	return Ruby(Attr(), children...)
 }
 
 func S(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("s", attrs, children...)
+	return Element("s", attrs, children...)
 }
 
 func S_(children ...HTML) HTML {
-    return S(Attr(), children...)
+	return S(Attr(), children...)
 }
 
 func Samp(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("samp", attrs, children...)
+	return Element("samp", attrs, children...)
 }
 
 func Samp_(children ...HTML) HTML {
-    return Samp(Attr(), children...)
+	return Samp(Attr(), children...)
+}
+
+func Search(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("search", attrs, children...)
+}
+
+func Search_(children ...HTML) HTML {
+	return Search(Attr(), children...)
 }
 
 func Section(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("section", attrs, children...)
+	return Element("section", attrs, children...)
 }
 
 func Section_(children ...HTML) HTML {
-    return Section(Attr(), children...)
+	return Section(Attr(), children...)
 }
 
 func Select(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("select", attrs, children...)
+	return Element("select", attrs, children...)
 }
 
 func Select_(children ...HTML) HTML {
-    return Select(Attr(), children...)
+	return Select(Attr(), children...)
 }
 
-func Small(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("small", attrs, children...)
+func Slot(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("slot", attrs, children...)
 }
 
-func Small_(children ...HTML) HTML {
-    return Small(Attr(), children...)
+func Slot_(children ...HTML) HTML {
+	return Slot(Attr(), children...)
 }
 
-func Spacer(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("spacer", attrs, children...)
+func Small(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("small", attrs, children...)
 }
 
-func Spacer_(children ...HTML) HTML {
-    return Spacer(Attr(), children...)
+func Small_(children ...HTML) HTML {
+	return Small(Attr(), children...)
 }
 
 func Span(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("span", attrs, children...)
+	return Element("span", attrs, children...)
 }
 
 func Span_(children ...HTML) HTML {
-    return Span(Attr(), children...)
-}
-
-func Strike(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("strike", attrs, children...)
-}
-
-func Strike_(children ...HTML) HTML {
-    return Strike(Attr(), children...)
+	return Span(Attr(), children...)
 }
 
 func Strong(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("strong", attrs, children...)
+	return Element("strong", attrs, children...)
 }
 
 func Strong_(children ...HTML) HTML {
-    return Strong(Attr(), children...)
-}
-
-func Style(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("style", attrs, children...)
-}
-
-func Style_(children ...HTML) HTML {
-    return Style(Attr(), children...)
+	return Strong(Attr(), children...)
 }
 
 func Sub(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("sub", attrs, children...)
+	return Element("sub", attrs, children...)
 }
 
 func Sub_(children ...HTML) HTML {
-    return Sub(Attr(), children...)
+	return Sub(Attr(), children...)
 }
 
 func Summary(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("summary", attrs, children...)
+	return Element("summary", attrs, children...)
 }
 
 func Summary_(children ...HTML) HTML {
-    return Summary(Attr(), children...)
+	return Summary(Attr(), children...)
 }
 
 func Sup(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("sup", attrs, children...)
+	return Element("sup", attrs, children...)
 }
 
 func Sup_(children ...HTML) HTML {
-    return Sup(Attr(), children...)
+	return Sup(Attr(), children...)
 }
 
 func Table(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("table", attrs, children...)
+	return Element("table", attrs, children...)
 }
 
 func Table_(children ...HTML) HTML {
-    return Table(Attr(), children...)
+	return Table(Attr(), children...)
 }
 
 func Tbody(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("tbody", attrs, children...)
+	return Element("tbody", attrs, children...)
 }
 
 func Tbody_(children ...HTML) HTML {
-    return Tbody(Attr(), children...)
+	return Tbody(Attr(), children...)
 }
 
 func Td(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("td", attrs, children...)
+	return Element("td", attrs, children...)
 }
 
 func Td_(children ...HTML) HTML {
-    return Td(Attr(), children...)
+	return Td(Attr(), children...)
+}
+
+func Template(attrs []a.Attribute, children ...HTML) HTML {
+	return Element("template", attrs, children...)
+}
+
+func Template_(children ...HTML) HTML {
+	return Template(Attr(), children...)
 }
 
 func Textarea(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("textarea", attrs, children...)
+	return Element("textarea", attrs, children...)
 }
 
 func Textarea_(children ...HTML) HTML {
-    return Textarea(Attr(), children...)
+	return Textarea(Attr(), children...)
 }
 
 func Tfoot(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("tfoot", attrs, children...)
+	return Element("tfoot", attrs, children...)
 }
 
 func Tfoot_(children ...HTML) HTML {
-    return Tfoot(Attr(), children...)
+	return Tfoot(Attr(), children...)
 }
 
 func Th(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("th", attrs, children...)
+	return Element("th", attrs, children...)
 }
 
 func Th_(children ...HTML) HTML {
-    return Th(Attr(), children...)
+	return Th(Attr(), children...)
 }
 
 func Thead(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("thead", attrs, children...)
+	return Element("thead", attrs, children...)
 }
 
 func Thead_(children ...HTML) HTML {
-    return Thead(Attr(), children...)
+	return Thead(Attr(), children...)
 }
 
 func Time(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("time", attrs, children...)
+	return Element("time", attrs, children...)
 }
 
 func Time_(children ...HTML) HTML {
-    return Time(Attr(), children...)
+	return Time(Attr(), children...)
 }
 
 func Title(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("title", attrs, children...)
+	return Element("title", attrs, children...)
 }
 
 func Title_(children ...HTML) HTML {
-    return Title(Attr(), children...)
+	return Title(Attr(), children...)
 }
 
 func Tr(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("tr", attrs, children...)
+	return Element("tr", attrs, children...)
 }
 
 func Tr_(children ...HTML) HTML {
-    return Tr(Attr(), children...)
-}
-
-func Tt(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("tt", attrs, children...)
-}
-
-func Tt_(children ...HTML) HTML {
-    return Tt(Attr(), children...)
+	return Tr(Attr(), children...)
 }
 
 func U(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("u", attrs, children...)
+	return Element("u", attrs, children...)
 }
 
 func U_(children ...HTML) HTML {
-    return U(Attr(), children...)
+	return U(Attr(), children...)
 }
 
 func Ul(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("ul", attrs, children...)
+	return Element("ul", attrs, children...)
 }
 
 func Ul_(children ...HTML) HTML {
-    return Ul(Attr(), children...)
+	return Ul(Attr(), children...)
 }
 
 func Var(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("var", attrs, children...)
+	return Element("var", attrs, children...)
 }
 
 func Var_(children ...HTML) HTML {
-    return Var(Attr(), children...)
+	return Var(Attr(), children...)
 }
 
 func Video(attrs []a.Attribute, children ...HTML) HTML {
-    return Element("video", attrs, children...)
+	return Element("video", attrs, children...)
 }
 
 func Video_(children ...HTML) HTML {
-    return Video(Attr(), children...)
+	return Video(Attr(), children...)
 }
 
-
 // Begin of generated void elements
 
-
 func Area(attrs []a.Attribute) HTML {
-    return VoidElement("area", attrs)
+	return VoidElement("area", attrs)
 }
 func Area_() HTML {
-    return Area(Attr())
+	return Area(Attr())
 }
 
 func Base(attrs []a.Attribute) HTML {
-    return VoidElement("base", attrs)
+	return VoidElement("base", attrs)
 }
 func Base_() HTML {
-    return Base(Attr())
+	return Base(Attr())
 }
 
 func Br(attrs []a.Attribute) HTML {
-    return VoidElement("br", attrs)
+	return VoidElement("br", attrs)
 }
 func Br_() HTML {
-    return Br(Attr())
+	return Br(Attr())
 }
 
 func Col(attrs []a.Attribute) HTML {
-    return VoidElement("col", attrs)
+	return VoidElement("col", attrs)
 }
 func Col_() HTML {
-    return Col(Attr())
+	return Col(Attr())
 }
 
 func Embed(attrs []a.Attribute) HTML {
-    return VoidElement("embed", attrs)
+	return VoidElement("embed", attrs)
 }
 func Embed_() HTML {
-    return Embed(Attr())
+	return Embed(Attr())
 }
 
 func Hr(attrs []a.Attribute) HTML {
-    return VoidElement("hr", attrs)
+	return VoidElement("hr", attrs)
 }
 func Hr_() HTML {
-    return Hr(Attr())
+	return Hr(Attr())
 }
 
 func Img(attrs []a.Attribute) HTML {
-    return VoidElement("img", attrs)
+	return VoidElement("img", attrs)
 }
 func Img_() HTML {
-    return Img(Attr())
+	return Img(Attr())
 }
 
 func Input(attrs []a.Attribute) HTML {
-    return VoidElement("input", attrs)
+	return VoidElement("input", attrs)
 }
 func Input_() HTML {
-    return Input(Attr())
+	return Input(Attr())
 }
 
 func Link(attrs []a.Attribute) HTML {
-    return VoidElement("link", attrs)
+	return VoidElement("link", attrs)
 }
 func Link_() HTML {
-    return Link(Attr())
+	return Link(Attr())
 }
 
 func Meta(attrs []a.Attribute) HTML {
-    return VoidElement("meta", attrs)
+	return VoidElement("meta", attrs)
 }
 func Meta_() HTML {
-    return Meta(Attr())
-}
-
-func Param(attrs []a.Attribute) HTML {
-    return VoidElement("param", attrs)
-}
-func Param_() HTML {
-    return Param(Attr())
+	return Meta(Attr())
 }
 
 func Source(attrs []a.Attribute) HTML {
-    return VoidElement("source", attrs)
+	return VoidElement("source", attrs)
 }
 func Source_() HTML {
-    return Source(Attr())
+	return Source(Attr())
 }
 
 func Track(attrs []a.Attribute) HTML {
-    return VoidElement("track", attrs)
+	return VoidElement("track", attrs)
 }
 func Track_() HTML {
-    return Track(Attr())
+	return Track(Attr())
 }
 
 func Wbr(attrs []a.Attribute) HTML {
-    return VoidElement("wbr", attrs)
+	return VoidElement("wbr", attrs)
 }
 func Wbr_() HTML {
-    return Wbr(Attr())
+	return Wbr(Attr())
 }
-
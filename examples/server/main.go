@@ -33,9 +33,9 @@ func page(title string, content HTML) HTML {
 	p :=
 		Html5_(
 			Head_(
-				Title_(Text(title)),
+				TitleText_(title),
 				Meta(Attr(a.Charset_("utf-8"))),
-				Meta(Attr(a.Name_("viewport"), a.Content_("width=device-width"), a.InitialScale_("1"))),
+				Meta(Attr(a.Name_("viewport"), a.Content_("width=device-width, initial-scale=1"))),
 				Link(Attr(a.Rel_("stylesheet"), a.Href_("/static/css/main.min.css")))),
 			Body_(
 				content,
@@ -0,0 +1,328 @@
+// Package feeds renders Atom 1.0 and RSS 2.0 feeds from typed entries,
+// whose content is HTML built with htmlgo:
+//
+//	feed := feeds.Feed{
+//	    Title:    "Blog",
+//	    Link:     "https://example.com/",
+//	    FeedLink: "https://example.com/atom.xml",
+//	    Author:   feeds.Person{Name: "Jane"},
+//	    Entries: []feeds.Entry{{
+//	        Title:     "Hello",
+//	        Link:      "https://example.com/hello",
+//	        Published: published,
+//	        Content:   Article_(P_(Text("Hello world"))),
+//	    }},
+//	}
+//	atom, err := feeds.Atom(feed)
+//	if err != nil {
+//	    return err
+//	}
+//	h.WriteTo(w, atom)
+//
+// Feeds missing the fields their format requires are rejected with an
+// error rather than rendered invalid, e.g. an Atom feed without any link or
+// update time.
+//
+// The documents are built from elements and attributes of htmlgo and
+// serialised as XML by its XHTML renderer, so that text and attributes are
+// escaped like in pages, e.g. links are filtered for unsafe URLs. Content
+// embedded as CDATA section is kept verbatim.
+package feeds
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	h "github.com/julvo/htmlgo"
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+const (
+	xmlDeclaration = `<?xml version="1.0" encoding="UTF-8"?>`
+	atomNamespace  = "http://www.w3.org/2005/Atom"
+)
+
+// Feed is a feed of entries, e.g. the posts of a blog
+type Feed struct {
+	// ID identifies the feed in Atom, defaulting to FeedLink or Link
+	ID    string
+	Title string
+	// Description is the subtitle in Atom
+	Description string
+	// Link is the URL of the website, FeedLink the URL of the feed itself
+	Link     string
+	FeedLink string
+	Author   Person
+	// Updated defaults to the latest update of the entries
+	Updated  time.Time
+	Language string
+	Rights   string
+	// CDATA embeds the content of entries as CDATA sections instead of
+	// escaped text
+	CDATA   bool
+	Entries []Entry
+}
+
+// Person is the author of a feed or an entry
+type Person struct {
+	Name  string
+	Email string
+	URI   string
+}
+
+// Entry is an entry of a feed
+type Entry struct {
+	// ID identifies the entry, defaulting to Link
+	ID        string
+	Title     string
+	Link      string
+	Author    Person
+	Published time.Time
+	// Updated defaults to Published
+	Updated    time.Time
+	Categories []string
+	// Summary is plain text, used as description in RSS if there is no
+	// content
+	Summary string
+	Content h.HTML
+}
+
+// Atom renders the feed as Atom 1.0 document. Atom requires the feed and
+// each entry to have an ID, defaulting to their links, and an update time,
+// defaulting to the times of the entries.
+func Atom(feed Feed) (h.HTML, error) {
+	id := firstOf(feed.ID, feed.FeedLink, feed.Link)
+	if id == "" {
+		return "", errors.New("feeds: Atom feed without ID or link")
+	}
+	if feedUpdated(feed).IsZero() {
+		return "", errors.New("feeds: Atom feed without update time")
+	}
+	children := []h.HTML{
+		element("id", h.Attr(), h.Text(id)),
+		element("title", h.Attr(), h.Text(feed.Title)),
+	}
+	if feed.Description != "" {
+		children = append(children, element("subtitle", h.Attr(), h.Text(feed.Description)))
+	}
+	children = append(children, element("updated", h.Attr(), h.Text(feedUpdated(feed).Format(time.RFC3339))))
+	if feed.Link != "" {
+		children = append(children, element("link", h.Attr(a.Custom("href", feed.Link), a.Custom_("rel", "alternate"))))
+	}
+	if feed.FeedLink != "" {
+		children = append(children, element("link", h.Attr(a.Custom("href", feed.FeedLink), a.Custom_("rel", "self"))))
+	}
+	if feed.Author.Name != "" {
+		children = append(children, atomPerson("author", feed.Author))
+	}
+	if feed.Rights != "" {
+		children = append(children, element("rights", h.Attr(), h.Text(feed.Rights)))
+	}
+	for i, entry := range feed.Entries {
+		e, err := atomEntry(entry, feed.CDATA)
+		if err != nil {
+			return "", fmt.Errorf("feeds: entry %d: %v", i, err)
+		}
+		children = append(children, e)
+	}
+
+	attrs := h.Attr(a.Custom("xmlns", atomNamespace))
+	if feed.Language != "" {
+		attrs = append(attrs, a.Custom("xml:lang", feed.Language))
+	}
+	return document(element("feed", attrs, children...)), nil
+}
+
+func atomEntry(entry Entry, cdata bool) (h.HTML, error) {
+	id := firstOf(entry.ID, entry.Link)
+	if id == "" {
+		return "", errors.New("no ID or link")
+	}
+	if entryUpdated(entry).IsZero() {
+		return "", errors.New("no update or publication time")
+	}
+	children := []h.HTML{
+		element("id", h.Attr(), h.Text(id)),
+		element("title", h.Attr(), h.Text(entry.Title)),
+	}
+	if entry.Link != "" {
+		children = append(children, element("link", h.Attr(a.Custom("href", entry.Link), a.Custom_("rel", "alternate"))))
+	}
+	if !entry.Published.IsZero() {
+		children = append(children, element("published", h.Attr(), h.Text(entry.Published.Format(time.RFC3339))))
+	}
+	children = append(children, element("updated", h.Attr(), h.Text(entryUpdated(entry).Format(time.RFC3339))))
+	if entry.Author.Name != "" {
+		children = append(children, atomPerson("author", entry.Author))
+	}
+	for _, category := range entry.Categories {
+		children = append(children, element("category", h.Attr(a.Custom("term", category))))
+	}
+	if entry.Summary != "" {
+		children = append(children, element("summary", h.Attr(), h.Text(entry.Summary)))
+	}
+	if entry.Content != "" {
+		children = append(children, element("content", h.Attr(a.Custom_("type", "html")), content(entry.Content, cdata)))
+	}
+	return element("entry", h.Attr(), children...), nil
+}
+
+func atomPerson(tag string, person Person) h.HTML {
+	children := []h.HTML{element("name", h.Attr(), h.Text(person.Name))}
+	if person.Email != "" {
+		children = append(children, element("email", h.Attr(), h.Text(person.Email)))
+	}
+	if person.URI != "" {
+		children = append(children, element("uri", h.Attr(), h.Text(person.URI)))
+	}
+	return element(tag, h.Attr(), children...)
+}
+
+// RSS renders the feed as RSS 2.0 document. RSS requires the feed to have a
+// title and a link, and each entry to have a title or a description, i.e. a
+// summary or content. Authors are only included if they have an email
+// address, and times if they are set.
+func RSS(feed Feed) (h.HTML, error) {
+	if feed.Title == "" || feed.Link == "" {
+		return "", errors.New("feeds: RSS feed without title or link")
+	}
+	for i, entry := range feed.Entries {
+		if entry.Title == "" && entry.Summary == "" && entry.Content == "" {
+			return "", fmt.Errorf("feeds: entry %d: no title or description", i)
+		}
+	}
+	children := []h.HTML{
+		element("title", h.Attr(), h.Text(feed.Title)),
+		element("link", h.Attr(), link(feed.Link)),
+		element("description", h.Attr(), h.Text(feed.Description)),
+	}
+	if feed.FeedLink != "" {
+		children = append(children, element("atom:link", h.Attr(
+			a.Custom("href", feed.FeedLink),
+			a.Custom_("rel", "self"),
+			a.Custom_("type", "application/rss+xml"))))
+	}
+	if feed.Language != "" {
+		children = append(children, element("language", h.Attr(), h.Text(feed.Language)))
+	}
+	if feed.Rights != "" {
+		children = append(children, element("copyright", h.Attr(), h.Text(feed.Rights)))
+	}
+	if feed.Author.Email != "" {
+		children = append(children, element("managingEditor", h.Attr(), h.Text(rssPerson(feed.Author))))
+	}
+	if updated := feedUpdated(feed); !updated.IsZero() {
+		children = append(children, element("lastBuildDate", h.Attr(), h.Text(updated.Format(time.RFC1123Z))))
+	}
+	for _, entry := range feed.Entries {
+		children = append(children, rssItem(entry, feed.CDATA))
+	}
+
+	return document(element("rss", h.Attr(a.Custom_("version", "2.0"), a.Custom("xmlns:atom", atomNamespace)),
+		element("channel", h.Attr(), children...))), nil
+}
+
+func rssItem(entry Entry, cdata bool) h.HTML {
+	children := []h.HTML{}
+	if entry.Title != "" {
+		children = append(children, element("title", h.Attr(), h.Text(entry.Title)))
+	}
+	if entry.Link != "" {
+		children = append(children, element("link", h.Attr(), link(entry.Link)))
+	}
+	if id := firstOf(entry.ID, entry.Link); id != "" {
+		permalink, guid := "false", h.Text(id)
+		if id == entry.Link {
+			permalink, guid = "true", link(id)
+		}
+		children = append(children, element("guid", h.Attr(a.Custom("isPermaLink", permalink)), guid))
+	}
+	if !entry.Published.IsZero() {
+		children = append(children, element("pubDate", h.Attr(), h.Text(entry.Published.Format(time.RFC1123Z))))
+	}
+	if entry.Author.Email != "" {
+		children = append(children, element("author", h.Attr(), h.Text(rssPerson(entry.Author))))
+	}
+	for _, category := range entry.Categories {
+		children = append(children, element("category", h.Attr(), h.Text(category)))
+	}
+	switch {
+	case entry.Content != "":
+		children = append(children, element("description", h.Attr(), content(entry.Content, cdata)))
+	case entry.Summary != "":
+		children = append(children, element("description", h.Attr(), h.Text(entry.Summary)))
+	}
+	return element("item", h.Attr(), children...)
+}
+
+// rssPerson formats a person as email address with the name in parentheses
+func rssPerson(person Person) string {
+	if person.Name == "" {
+		return person.Email
+	}
+	return person.Email + " (" + person.Name + ")"
+}
+
+// link produces the text of an element holding a URL, which is filtered like
+// the href attributes of Atom, e.g. javascript: URLs are replaced
+func link(url string) h.HTML {
+	for _, attr := range a.Render([]a.Attribute{a.Custom("href", url)}) {
+		return h.Text_(attr.Value)
+	}
+	return ""
+}
+
+// element produces an element of the feed, which is empty if it has no
+// children, as in XML
+func element(tag string, attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement(tag, attrs, children...)
+}
+
+// document serialises the root element as XML document
+func document(root h.HTML) h.HTML {
+	return h.Renderer{Indent: "  ", XHTML: true}.Render(h.HTML(xmlDeclaration) + root)
+}
+
+// content embeds HTML into the feed. Line breaks of the escaped HTML are
+// escaped too, so that the indentation of the feed does not change them.
+func content(c h.HTML, cdata bool) h.HTML {
+	s := string(h.Renderer{Compact: true}.Render(c))
+	if cdata {
+		return h.CDATA(s)
+	}
+	return h.Text_(strings.Replace(html.EscapeString(s), "\n", "&#10;", -1))
+}
+
+// feedUpdated returns the update time of the feed, defaulting to the latest
+// update of its entries
+func feedUpdated(feed Feed) time.Time {
+	if !feed.Updated.IsZero() {
+		return feed.Updated
+	}
+	updated := time.Time{}
+	for _, entry := range feed.Entries {
+		if t := entryUpdated(entry); t.After(updated) {
+			updated = t
+		}
+	}
+	return updated
+}
+
+func entryUpdated(entry Entry) time.Time {
+	if entry.Updated.IsZero() {
+		return entry.Published
+	}
+	return entry.Updated
+}
+
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
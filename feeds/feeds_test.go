@@ -0,0 +1,166 @@
+package feeds
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	h "github.com/julvo/htmlgo"
+)
+
+var published = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		render func(Feed) (h.HTML, error)
+		feed   Feed
+		want   string
+	}{
+		{"atom without ID", Atom, Feed{Title: "Blog", Updated: published}, "feeds: Atom feed without ID or link"},
+		{"atom without update time", Atom, Feed{Link: "https://a.b/"}, "feeds: Atom feed without update time"},
+		{
+			"atom without entry times", Atom,
+			Feed{Link: "https://a.b/", Entries: []Entry{{Link: "https://a.b/1"}}},
+			"feeds: Atom feed without update time",
+		},
+		{
+			"atom entry without ID", Atom,
+			Feed{Link: "https://a.b/", Entries: []Entry{{Link: "https://a.b/1", Published: published}, {Published: published}}},
+			"feeds: entry 1: no ID or link",
+		},
+		{
+			"atom entry without time", Atom,
+			Feed{Link: "https://a.b/", Updated: published, Entries: []Entry{{Link: "https://a.b/1"}}},
+			"feeds: entry 0: no update or publication time",
+		},
+		{"rss without title", RSS, Feed{Link: "https://a.b/"}, "feeds: RSS feed without title or link"},
+		{"rss without link", RSS, Feed{Title: "Blog"}, "feeds: RSS feed without title or link"},
+		{
+			"rss entry without title or description", RSS,
+			Feed{Title: "Blog", Link: "https://a.b/", Entries: []Entry{{Link: "https://a.b/1"}}},
+			"feeds: entry 0: no title or description",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.render(tt.feed)
+			if err == nil || err.Error() != tt.want {
+				t.Fatalf("got error %v, want %q", err, tt.want)
+			}
+			if got != "" {
+				t.Errorf("got %q, want no document", got)
+			}
+		})
+	}
+}
+
+func TestFeeds(t *testing.T) {
+	feed := Feed{
+		Title:       "Blog & more",
+		Description: "Posts",
+		Link:        "https://a.b/",
+		FeedLink:    "https://a.b/feed.xml",
+		Author:      Person{Name: "Jane", Email: "jane@a.b"},
+		Language:    "en",
+		Entries: []Entry{{
+			Title:      "Hello",
+			Link:       "https://a.b/hello",
+			Published:  published,
+			Categories: []string{"news"},
+			Content:    h.P_(h.Text("Hello <world>")),
+		}, {
+			ID:        "urn:2",
+			Title:     "Unsafe",
+			Link:      "javascript:alert(1)",
+			Published: published.Add(time.Hour),
+			Summary:   "Summary",
+		}},
+	}
+	cdata := feed
+	cdata.CDATA = true
+
+	tests := []struct {
+		name   string
+		render func(Feed) (h.HTML, error)
+		feed   Feed
+		want   []string
+		reject []string
+	}{
+		{
+			"atom", Atom, feed,
+			[]string{
+				`<?xml version="1.0" encoding="UTF-8"?>`,
+				`<feed xmlns="http://www.w3.org/2005/Atom" xml:lang="en">`,
+				"<id>https://a.b/feed.xml</id>",
+				"<title>Blog &amp; more</title>",
+				"<subtitle>Posts</subtitle>",
+				"<updated>2024-03-01T13:00:00Z</updated>",
+				`<link href="https://a.b/" rel="alternate"/>`,
+				`<link href="https://a.b/feed.xml" rel="self"/>`,
+				"<id>https://a.b/hello</id>",
+				"<published>2024-03-01T12:00:00Z</published>",
+				`<category term="news"/>`,
+				`<content type="html">&lt;p&gt;Hello &amp;lt;world&amp;gt;&lt;/p&gt;</content>`,
+				"<id>urn:2</id>",
+				`<link href="#ZgotmplZ" rel="alternate"/>`,
+				"<summary>Summary</summary>",
+			},
+			[]string{"javascript:", "<id/>", "0001-01-01", "lastBuildDate"},
+		},
+		{
+			"atom cdata", Atom, cdata,
+			[]string{`<content type="html"><![CDATA[<p>Hello &lt;world&gt;</p>]]></content>`},
+			nil,
+		},
+		{
+			"rss", RSS, feed,
+			[]string{
+				`<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">`,
+				"<title>Blog &amp; more</title>",
+				"<link>https://a.b/</link>",
+				`<atom:link href="https://a.b/feed.xml" rel="self" type="application/rss+xml"/>`,
+				"<managingEditor>jane@a.b (Jane)</managingEditor>",
+				"<lastBuildDate>Fri, 01 Mar 2024 13:00:00 +0000</lastBuildDate>",
+				`<guid isPermaLink="true">https://a.b/hello</guid>`,
+				"<pubDate>Fri, 01 Mar 2024 12:00:00 +0000</pubDate>",
+				"<category>news</category>",
+				"<description>&lt;p&gt;Hello &amp;lt;world&amp;gt;&lt;/p&gt;</description>",
+				"<link>#ZgotmplZ</link>",
+				`<guid isPermaLink="false">urn:2</guid>`,
+				"<description>Summary</description>",
+			},
+			[]string{"javascript:"},
+		},
+		{
+			"rss cdata", RSS, cdata,
+			[]string{"<description><![CDATA[<p>Hello &lt;world&gt;</p>]]></description>"},
+			nil,
+		},
+		{
+			"rss without times", RSS, Feed{Title: "Blog", Link: "https://a.b/", Entries: []Entry{{Summary: "a"}}},
+			[]string{"<item>\n      <description>a</description>\n    </item>"},
+			[]string{"lastBuildDate", "pubDate", "<title/>", "<guid"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.render(tt.feed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(got), want) {
+					t.Errorf("got %s\nwant it to contain %s", got, want)
+				}
+			}
+			for _, reject := range tt.reject {
+				if strings.Contains(string(got), reject) {
+					t.Errorf("got %s\nwant it not to contain %s", got, reject)
+				}
+			}
+		})
+	}
+}
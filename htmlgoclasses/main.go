@@ -0,0 +1,81 @@
+// Command htmlgoclasses writes a safelist of the class tokens used with the
+// Class family of htmlgo/attributes, for PurgeCSS or Tailwind to keep the
+// corresponding rules of a utility stylesheet:
+//
+//	go run github.com/julvo/htmlgo/htmlgoclasses -o safelist.txt ./...
+//
+// Sources are analysed statically. Classes built from data are reported on
+// stderr with their position, so that they can be added with -add or
+// covered by recording a render with the safelist package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/julvo/htmlgo/safelist"
+)
+
+func main() {
+	out := flag.String("o", "", "write the safelist to this file instead of stdout")
+	format := flag.String("format", "text", "format of the safelist, text or json")
+	add := flag.String("add", "", "space-separated classes to add, e.g. for dynamic classes")
+	strict := flag.Bool("strict", false, "fail if dynamic classes are found")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [paths...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(*out, *format, *add, *strict, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "htmlgoclasses:", err)
+		os.Exit(1)
+	}
+}
+
+func run(out, format, add string, strict bool, paths []string) error {
+	if format != "text" && format != "json" {
+		return fmt.Errorf("unknown format %q", format)
+	}
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	for i, p := range paths {
+		// accept package patterns such as ./...
+		paths[i] = strings.TrimSuffix(strings.TrimSuffix(p, "..."), "/")
+		if paths[i] == "" {
+			paths[i] = "."
+		}
+	}
+
+	report, err := safelist.ScanFiles(paths...)
+	if err != nil {
+		return err
+	}
+	report.Add(add)
+
+	for _, d := range report.Dynamic() {
+		fmt.Fprintln(os.Stderr, "dynamic class:", d)
+	}
+	if strict && len(report.Dynamic()) > 0 {
+		return fmt.Errorf("%d dynamic class expressions", len(report.Dynamic()))
+	}
+
+	buf := new(bytes.Buffer)
+	if format == "json" {
+		err = report.WriteJSON(buf)
+	} else {
+		err = report.WriteText(buf)
+	}
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	return os.WriteFile(out, buf.Bytes(), 0644)
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+    "encoding/json"
+    "fmt"
+    "go/token"
+    "os"
+    "strings"
+
+    "github.com/julvo/htmlgo"
+)
+
+// CustomSpec describes project-specific custom elements, for which a
+// package of typed element and attribute functions is generated with -custom
+//
+//  {
+//      "elements": [{
+//          "name": "acme-modal",
+//          "description": "A modal dialog",
+//          "attributes": [
+//              {"name": "open", "type": "boolean"},
+//              {"name": "size", "type": "enum", "values": ["sm", "md", "lg"]},
+//              {"name": "heading", "type": "string"},
+//              {"name": "max-width", "type": "number"}
+//          ],
+//          "slots": [{"name": "footer"}],
+//          "events": [{"name": "acme-close"}]
+//      }]
+//  }
+type CustomSpec struct {
+    Elements    []CustomElement     `json:"elements"`
+}
+
+type CustomElement struct {
+    Name        string              `json:"name"`
+    Description string              `json:"description"`
+    Attributes  []CustomAttribute   `json:"attributes"`
+    Slots       []CustomSlot        `json:"slots"`
+    Events      []CustomEvent       `json:"events"`
+}
+
+// CustomAttribute is an attribute of a custom element. Type is one of
+// string, number, boolean or enum, defaulting to string.
+type CustomAttribute struct {
+    Name        string              `json:"name"`
+    Description string              `json:"description"`
+    Type        string              `json:"type"`
+    Values      []string            `json:"values"`
+}
+
+// CustomSlot is a named slot of a custom element. Children are assigned to
+// it using the slot attribute.
+type CustomSlot struct {
+    Name        string              `json:"name"`
+    Description string              `json:"description"`
+}
+
+type CustomEvent struct {
+    Name        string              `json:"name"`
+    Description string              `json:"description"`
+}
+
+type CustomParams struct {
+    Package     string
+    Elements    []CustomElementFunc
+    UsesNumbers bool
+}
+
+type CustomElementFunc struct {
+    FuncName    string
+    TagName     string
+    Doc         string
+    Attributes  []CustomAttributeFunc
+    Slots       []CustomSlotFunc
+    Events      []CustomEventConst
+}
+
+type CustomAttributeFunc struct {
+    FuncName    string
+    AttrName    string
+    Doc         string
+    Type        string
+    // ValueType is the type of enum values
+    ValueType   string
+    Values      []CustomEnumValue
+}
+
+type CustomEnumValue struct {
+    ConstName   string
+    Value       string
+}
+
+type CustomSlotFunc struct {
+    FuncName    string
+    SlotName    string
+    Doc         string
+}
+
+type CustomEventConst struct {
+    ConstName   string
+    EventName   string
+    Doc         string
+}
+
+// LoadCustomSpec reads a custom element spec from a JSON file
+func LoadCustomSpec(path string) (CustomSpec, error) {
+    spec := CustomSpec{}
+    b, err := os.ReadFile(path)
+    if err != nil {
+        return spec, err
+    }
+    dec := json.NewDecoder(strings.NewReader(string(b)))
+    dec.DisallowUnknownFields()
+    if err := dec.Decode(&spec); err != nil {
+        return spec, fmt.Errorf("%s: %v", path, err)
+    }
+    return spec, nil
+}
+
+// validateAttributeName rejects names which cannot be written in a start tag
+func validateAttributeName(name string) error {
+    if name == "" {
+        return fmt.Errorf("empty attribute name")
+    }
+    if i := strings.IndexAny(name, " \t\n\f\r\"'<>/={}`"); i >= 0 {
+        return fmt.Errorf("attribute name %q must not contain %q", name, name[i])
+    }
+    return nil
+}
+
+// NewCustomParams validates a custom element spec and derives the names of
+// the generated functions, types and constants
+func NewCustomParams(spec CustomSpec, pkg string) (CustomParams, error) {
+    ps := CustomParams{Package: pkg}
+    var problems []string
+    seen := map[string]string{}
+    declare := func(ident, what string) {
+        if !token.IsIdentifier(ident) {
+            problems = append(problems, fmt.Sprintf("%s maps to %q, which is not a valid identifier", what, ident))
+        } else if other, ok := seen[ident]; ok {
+            problems = append(problems, fmt.Sprintf("%s maps to %s, which collides with %s", what, ident, other))
+        } else {
+            seen[ident] = what
+        }
+    }
+
+    if len(spec.Elements) == 0 {
+        return ps, fmt.Errorf("custom element spec without elements")
+    }
+    for _, el := range spec.Elements {
+        if err := htmlgo.ValidateCustomElementName(el.Name); err != nil {
+            problems = append(problems, err.Error())
+            continue
+        }
+        f := CustomElementFunc{
+            FuncName:   GetFuncName(el.Name),
+            TagName:    el.Name,
+        }
+        f.Doc = docComment(f.FuncName, fmt.Sprintf("builds a <%s> element", el.Name), el.Description)
+        declare(f.FuncName, fmt.Sprintf("element %q", el.Name))
+        declare(f.FuncName+"_", fmt.Sprintf("element %q", el.Name))
+
+        for _, attr := range el.Attributes {
+            what := fmt.Sprintf("attribute %q of %q", attr.Name, el.Name)
+            if err := validateAttributeName(attr.Name); err != nil {
+                problems = append(problems, err.Error())
+                continue
+            }
+            af := CustomAttributeFunc{
+                FuncName:   f.FuncName + GetFuncName(attr.Name),
+                AttrName:   attr.Name,
+                Type:       attr.Type,
+            }
+            af.Doc = docComment(af.FuncName, fmt.Sprintf("sets the %s attribute", attr.Name), attr.Description)
+            switch attr.Type {
+            case "":
+                af.Type = "string"
+            case "string", "boolean":
+            case "number":
+                ps.UsesNumbers = true
+            case "enum":
+                if len(attr.Values) == 0 {
+                    problems = append(problems, what+" is an enum without values")
+                }
+                af.ValueType = af.FuncName + "Value"
+                declare(af.ValueType, what)
+                for _, v := range attr.Values {
+                    c := CustomEnumValue{ConstName: af.FuncName + GetFuncName(v), Value: v}
+                    declare(c.ConstName, fmt.Sprintf("value %q of %s", v, what))
+                    af.Values = append(af.Values, c)
+                }
+            default:
+                problems = append(problems, fmt.Sprintf("%s has unknown type %q", what, attr.Type))
+            }
+            declare(af.FuncName, what)
+            f.Attributes = append(f.Attributes, af)
+        }
+
+        for _, slot := range el.Slots {
+            what := fmt.Sprintf("slot %q of %q", slot.Name, el.Name)
+            if slot.Name == "" {
+                problems = append(problems, what+" has no name, children go into the default slot")
+                continue
+            }
+            sf := CustomSlotFunc{
+                FuncName:   f.FuncName + "Slot" + GetFuncName(slot.Name),
+                SlotName:   slot.Name,
+            }
+            sf.Doc = docComment(sf.FuncName, fmt.Sprintf("assigns an element to the %s slot", slot.Name), slot.Description)
+            declare(sf.FuncName, what)
+            f.Slots = append(f.Slots, sf)
+        }
+
+        for _, ev := range el.Events {
+            c := CustomEventConst{
+                ConstName:  f.FuncName + "Event" + GetFuncName(ev.Name),
+                EventName:  ev.Name,
+            }
+            c.Doc = docComment(c.ConstName, fmt.Sprintf("is the name of the %s event", ev.Name), ev.Description)
+            declare(c.ConstName, fmt.Sprintf("event %q of %q", ev.Name, el.Name))
+            f.Events = append(f.Events, c)
+        }
+        ps.Elements = append(ps.Elements, f)
+    }
+
+    if len(problems) > 0 {
+        return ps, fmt.Errorf("invalid custom element spec:\n  %s", strings.Join(problems, "\n  "))
+    }
+    return ps, nil
+}
+
+// docComment turns a description into the lines of the doc comment of
+// ident, which starts with ident as go doc expects. A description which does
+// not start with ident follows the summary sentence of ident.
+func docComment(ident, summary, description string) string {
+    description = strings.TrimSpace(description)
+    switch {
+    case description == "":
+        description = ident + " " + summary
+    case !strings.HasPrefix(description, ident+" "):
+        description = ident + " " + summary + "\n\n" + description
+    }
+    lines := strings.Split(description, "\n")
+    for i, l := range lines {
+        lines[i] = strings.TrimRight("// "+strings.TrimSpace(l), " ")
+    }
+    return strings.Join(lines, "\n")
+}
@@ -1,11 +1,19 @@
 package main
 
 import (
+    "bytes"
+    "embed"
+    "flag"
     "fmt"
+    "go/format"
+    "go/token"
+    "io/fs"
     "os"
-    "text/template"
+    pathpkg "path"
     "path/filepath"
+    "sort"
     "strings"
+    "text/template"
 )
 
 type ElementFunc struct {
@@ -18,57 +26,249 @@ type VoidElementFunc ElementFunc
 type AttributeFunc struct {
     FuncName    string
     AttrName    string
+    // Filter names a function of the generated package, which filters the
+    // data of the attribute
+    Filter      string
 }
 
 type Params struct {
-    ElementFuncs        []ElementFunc
-    VoidElementFuncs    []VoidElementFunc
-    AttributeFuncs      []AttributeFunc
+    Package                     string
+    ElementFuncs                []ElementFunc
+    VoidElementFuncs            []VoidElementFunc
+    ObsoleteElementFuncs        []ElementFunc
+    ObsoleteVoidElementFuncs    []VoidElementFunc
+    AttributeFuncs              []AttributeFunc
+    ObsoleteAttributeFuncs      []AttributeFunc
+    SvgElementFuncs             []ElementFunc
+    SvgAttributeFuncs           []AttributeFunc
+    MathmlElementFuncs          []ElementFunc
+    MathmlAttributeFuncs        []AttributeFunc
+}
+
+// Identifiers declared manually in the attributes templates and in
+// attributes/deprecated.go, which generated attribute functions must not
+// redeclare. The deprecated Align, Bgcolor and Border are left out, as they
+// share their names with the obsolete attributes of another package.
+var manualAttributeIdents = []string{"Attribute", "Dataset", "Dataset_",
+                                     "Dropzone", "Dropzone_", "InitialScale", "InitialScale_",
+                                     "Onmousewheel", "Onmousewheel_", "Onsearch", "Onsearch_"}
+
+//go:embed templates customtemplates
+var embeddedTemplates embed.FS
+
+// Options are the command line flags of htmlgogen
+type Options struct {
+    SpecPath    string
+    CustomPath  string
+    Manifest    string
+    TemplDir    string
+    Out         string
+    Package     string
+    Check       bool
 }
 
-const templDir = "htmlgogen/templates"
 // special-cases data-*, doctype
 func main() {
-    templPaths, err := filepath.Glob(filepath.Join(templDir, "*.go"))
-    check(err)
-    moreTemplPaths, err := filepath.Glob(filepath.Join(templDir, "/*/*.go"))
-    check(err)
-    templPaths = append(templPaths, moreTemplPaths...)
+    opts := Options{}
+    flag.StringVar(&opts.SpecPath, "spec", "", "JSON spec of elements and attributes, defaults to the HTML Living Standard, requires -templates")
+    flag.StringVar(&opts.CustomPath, "custom", "", "JSON spec of custom elements to generate a package for, requires -pkg")
+    flag.StringVar(&opts.Manifest, "manifest", "", "custom elements manifest (custom-elements.json) to generate a package for, requires -pkg")
+    flag.StringVar(&opts.TemplDir, "templates", "", "directory of templates, defaults to the built-in templates")
+    flag.StringVar(&opts.Out, "out", ".", "directory to write the generated files to")
+    flag.StringVar(&opts.Package, "pkg", "", "package name of the generated root package, defaults to htmlgo, requires -templates for other packages")
+    flag.BoolVar(&opts.Check, "check", false, "only check that the generated files are up to date")
+    flag.Usage = func() {
+        fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags]\n", os.Args[0])
+        flag.PrintDefaults()
+    }
+    flag.Parse()
+
+    if err := run(opts); err != nil {
+        fmt.Fprintln(os.Stderr, "htmlgogen:", err)
+        os.Exit(1)
+    }
+}
+
+func run(opts Options) error {
+    var params interface{}
+    templRoot := "templates"
+    if opts.CustomPath != "" && opts.Manifest != "" {
+        return fmt.Errorf("-custom and -manifest are mutually exclusive")
+    }
+    if opts.CustomPath != "" || opts.Manifest != "" {
+        if opts.Package == "" {
+            return fmt.Errorf("-custom and -manifest require -pkg")
+        }
+        templRoot = "customtemplates"
+        var spec CustomSpec
+        var err error
+        if opts.Manifest != "" {
+            spec, err = LoadManifest(opts.Manifest)
+        } else {
+            spec, err = LoadCustomSpec(opts.CustomPath)
+        }
+        if err != nil {
+            return err
+        }
+        if params, err = NewCustomParams(spec, opts.Package); err != nil {
+            return err
+        }
+    } else {
+        if opts.Package == "" {
+            opts.Package = "htmlgo"
+        }
+        // The built-in templates complete the hand-written files of this
+        // repository, e.g. elements.go declares only part of the package
+        if opts.TemplDir == "" && (opts.SpecPath != "" || opts.Package != "htmlgo") {
+            return fmt.Errorf("-spec and -pkg require -templates, the built-in templates only generate htmlgo")
+        }
+        spec := DefaultSpec()
+        if opts.SpecPath != "" {
+            var err error
+            if spec, err = LoadSpec(opts.SpecPath); err != nil {
+                return err
+            }
+        }
+        ps := NewParams(spec)
+        ps.Package = opts.Package
+        // Obsolete attributes live in their own package but share the template
+        // namespace of an element with the standard ones, so check them together
+        err := CheckCollisions(append(ps.AttributeFuncs, ps.ObsoleteAttributeFuncs...),
+                               manualAttributeIdents...)
+        if err != nil {
+            return err
+        }
+        if err := CheckCollisions(ps.SvgAttributeFuncs); err != nil {
+            return err
+        }
+        if err := CheckCollisions(ps.MathmlAttributeFuncs); err != nil {
+            return err
+        }
+        params = ps
+    }
+    if !token.IsIdentifier(opts.Package) {
+        return fmt.Errorf("invalid package name %q", opts.Package)
+    }
 
-    params := NewParams()
+    var templates fs.FS
+    if opts.TemplDir != "" {
+        templates = os.DirFS(opts.TemplDir)
+    } else {
+        var err error
+        if templates, err = fs.Sub(embeddedTemplates, templRoot); err != nil {
+            return err
+        }
+    }
 
-    for _, templPath := range templPaths {
-        saveAs, err := filepath.Rel(templDir, templPath)
-        check(err)
+    files, err := Generate(templates, params)
+    if err != nil {
+        return err
+    }
 
+    if opts.Check {
+        return Check(opts.Out, files)
+    }
+    for _, name := range sortedNames(files) {
+        saveAs := filepath.Join(opts.Out, filepath.FromSlash(name))
         fmt.Printf("Generating %s...\n", saveAs)
+        if err := os.MkdirAll(filepath.Dir(saveAs), 0755); err != nil {
+            return err
+        }
+        if err := os.WriteFile(saveAs, files[name], 0644); err != nil {
+            return err
+        }
+    }
+    return nil
+}
 
-        templ, err := template.New(filepath.Base(templPath)).
+// Generate executes all .go templates of templates with params, returning
+// the gofmt'd sources by their slash-separated paths relative to the
+// templates root
+func Generate(templates fs.FS, params interface{}) (map[string][]byte, error) {
+    files := map[string][]byte{}
+    err := fs.WalkDir(templates, ".", func(path string, d fs.DirEntry, err error) error {
+        if err != nil {
+            return err
+        }
+        if d.IsDir() || !strings.HasSuffix(path, ".go") {
+            return nil
+        }
+        templ, err := template.New(pathpkg.Base(path)).
                         Delims("[[", "]]").
-                        ParseFiles(templPath)
-        check(err)
-        err = os.MkdirAll(filepath.Dir(saveAs), 0744)
-        check(err)
-
-        f, err := os.Create(saveAs)
-        check(err)
-        defer f.Close()
+                        ParseFS(templates, path)
+        if err != nil {
+            return err
+        }
+        buf := new(bytes.Buffer)
+        if err := templ.Execute(buf, params); err != nil {
+            return err
+        }
+        src, err := format.Source(buf.Bytes())
+        if err != nil {
+            return fmt.Errorf("formatting %s: %v", path, err)
+        }
+        files[path] = src
+        return nil
+    })
+    if err != nil {
+        return nil, err
+    }
+    if len(files) == 0 {
+        return nil, fmt.Errorf("no templates found")
+    }
+    return files, nil
+}
 
-        err = templ.Execute(f, params)
-        check(err)
+// Check reports generated files which differ from the files in dir
+func Check(dir string, files map[string][]byte) error {
+    var stale []string
+    for _, name := range sortedNames(files) {
+        existing, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+        if err != nil && !os.IsNotExist(err) {
+            return err
+        }
+        if err != nil || !bytes.Equal(existing, files[name]) {
+            stale = append(stale, name)
+        }
+    }
+    if len(stale) > 0 {
+        return fmt.Errorf("generated files are out of date, rerun htmlgogen:\n  %s",
+                          strings.Join(stale, "\n  "))
     }
+    return nil
+}
 
+func sortedNames(files map[string][]byte) []string {
+    names := make([]string, 0, len(files))
+    for name := range files {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+    return names
 }
 
-func NewParams() Params {
+func NewParams(spec Spec) Params {
     ps := Params{
+        "",
+        []ElementFunc{},
+        []VoidElementFunc{},
         []ElementFunc{},
         []VoidElementFunc{},
         []AttributeFunc{},
+        []AttributeFunc{},
+        []ElementFunc{},
+        []AttributeFunc{},
+        []ElementFunc{},
+        []AttributeFunc{},
     }
 
-    for _, tag := range tags {
-        if _, ok := selfClosingTags[tag]; ok {
+    void := map[string]struct{}{}
+    for _, tag := range spec.VoidElements {
+        void[tag] = struct{}{}
+    }
+
+    for _, tag := range spec.Elements {
+        if _, ok := void[tag]; ok {
             ps.VoidElementFuncs = append(ps.VoidElementFuncs, VoidElementFunc{
                                              FuncName:  GetFuncName(tag),
                                              TagName:   tag,
@@ -80,25 +280,101 @@ func NewParams() Params {
                                          })
         }
     }
-    for _, attr := range attributes {
+    for _, tag := range spec.ObsoleteElements {
+        if _, ok := void[tag]; ok {
+            ps.ObsoleteVoidElementFuncs = append(ps.ObsoleteVoidElementFuncs, VoidElementFunc{
+                                             FuncName:  GetFuncName(tag),
+                                             TagName:   tag,
+                                         })
+        } else {
+            ps.ObsoleteElementFuncs = append(ps.ObsoleteElementFuncs, ElementFunc{
+                                             FuncName:  GetFuncName(tag),
+                                             TagName:   tag,
+                                         })
+        }
+    }
+    for _, attr := range spec.Attributes {
             ps.AttributeFuncs = append(ps.AttributeFuncs, AttributeFunc{
                                              FuncName:  GetFuncName(attr),
                                              AttrName:  attr,
                                          })
     }
+    for _, attr := range spec.ObsoleteAttributes {
+            ps.ObsoleteAttributeFuncs = append(ps.ObsoleteAttributeFuncs, AttributeFunc{
+                                             FuncName:  GetFuncName(attr),
+                                             AttrName:  attr,
+                                         })
+    }
+    for _, tag := range spec.SvgElements {
+            ps.SvgElementFuncs = append(ps.SvgElementFuncs, ElementFunc{
+                                             FuncName:  GetFuncName(tag),
+                                             TagName:   tag,
+                                         })
+    }
+    for _, attr := range spec.SvgAttributes {
+            ps.SvgAttributeFuncs = append(ps.SvgAttributeFuncs, AttributeFunc{
+                                             FuncName:  GetFuncName(attr),
+                                             AttrName:  attr,
+                                             Filter:    svgAttributeFilters[attr],
+                                         })
+    }
+    for _, tag := range spec.MathmlElements {
+            ps.MathmlElementFuncs = append(ps.MathmlElementFuncs, ElementFunc{
+                                             FuncName:  GetFuncName(tag),
+                                             TagName:   tag,
+                                         })
+    }
+    for _, attr := range spec.MathmlAttributes {
+            ps.MathmlAttributeFuncs = append(ps.MathmlAttributeFuncs, AttributeFunc{
+                                             FuncName:  GetFuncName(attr),
+                                             AttrName:  attr,
+                                         })
+    }
     return ps
 }
 
+// CheckCollisions reports attribute names which do not map to a valid Go
+// identifier or which map to the same identifier as another attribute or
+// as one of the reserved identifiers. Each attribute produces two functions,
+// FuncName and FuncName_, both of which are checked.
+func CheckCollisions(funcs []AttributeFunc, reserved ...string) error {
+    seen := map[string]string{}
+    for _, ident := range reserved {
+        seen[ident] = "manually declared " + ident
+    }
+
+    var problems []string
+    for _, f := range funcs {
+        if !token.IsIdentifier(f.FuncName) {
+            problems = append(problems, fmt.Sprintf(
+                "%q maps to %q, which is not a valid identifier", f.AttrName, f.FuncName))
+            continue
+        }
+        for _, ident := range []string{f.FuncName, f.FuncName + "_"} {
+            if other, ok := seen[ident]; ok {
+                problems = append(problems, fmt.Sprintf(
+                    "%q maps to %s, which collides with %s", f.AttrName, ident, other))
+                continue
+            }
+            seen[ident] = fmt.Sprintf("%q", f.AttrName)
+        }
+    }
+
+    if len(problems) > 0 {
+        return fmt.Errorf("attribute identifier collisions:\n  %s",
+                          strings.Join(problems, "\n  "))
+    }
+    return nil
+}
+
+// GetFuncName converts an element or attribute name into an exported
+// identifier, e.g. accept-charset to AcceptCharset and xlink:href to
+// XlinkHref. The case of the remaining letters is kept, so that viewBox
+// becomes ViewBox.
 func GetFuncName(s string) string {
-    parts := strings.Split(s, "-")
+    parts := strings.FieldsFunc(s, func(r rune) bool { return r == '-' || r == ':' })
     for i, p := range parts {
         parts[i] = strings.Title(p)
     }
     return strings.Join(parts, "")
 }
-
-func check(err error) {
-    if err != nil {
-        panic(err)
-    }
-}
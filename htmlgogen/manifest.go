@@ -0,0 +1,144 @@
+package main
+
+import (
+    "encoding/json"
+    "fmt"
+    "os"
+    "regexp"
+    "strings"
+)
+
+// Manifest is the subset of a Custom Elements Manifest (custom-elements.json)
+// which is needed to generate bindings, see
+// https://github.com/webcomponents/custom-elements-manifest
+type Manifest struct {
+    SchemaVersion   string              `json:"schemaVersion"`
+    Modules         []ManifestModule    `json:"modules"`
+}
+
+type ManifestModule struct {
+    Path            string                  `json:"path"`
+    Declarations    []ManifestDeclaration   `json:"declarations"`
+}
+
+type ManifestDeclaration struct {
+    Kind            string              `json:"kind"`
+    Name            string              `json:"name"`
+    TagName         string              `json:"tagName"`
+    Summary         string              `json:"summary"`
+    Description     string              `json:"description"`
+    Attributes      []ManifestMember    `json:"attributes"`
+    Slots           []ManifestMember    `json:"slots"`
+    Events          []ManifestMember    `json:"events"`
+}
+
+type ManifestMember struct {
+    Name            string              `json:"name"`
+    Description     string              `json:"description"`
+    Type            *ManifestType       `json:"type"`
+}
+
+type ManifestType struct {
+    Text            string              `json:"text"`
+}
+
+// LoadManifest reads a Custom Elements Manifest and converts its custom
+// element declarations into a custom element spec. Declarations without a
+// tag name, e.g. mixins and base classes, are skipped. Inherited
+// attributes are only included if the manifest lists them on the element,
+// as analyzers usually do.
+func LoadManifest(path string) (CustomSpec, error) {
+    b, err := os.ReadFile(path)
+    if err != nil {
+        return CustomSpec{}, err
+    }
+    m := Manifest{}
+    if err := json.Unmarshal(b, &m); err != nil {
+        return CustomSpec{}, fmt.Errorf("%s: %v", path, err)
+    }
+    if m.SchemaVersion == "" {
+        return CustomSpec{}, fmt.Errorf("%s: not a custom elements manifest, schemaVersion is missing", path)
+    }
+    return m.CustomSpec(), nil
+}
+
+// CustomSpec converts the custom element declarations of the manifest
+func (m Manifest) CustomSpec() CustomSpec {
+    spec := CustomSpec{}
+    for _, mod := range m.Modules {
+        for _, decl := range mod.Declarations {
+            if decl.TagName == "" {
+                continue
+            }
+            el := CustomElement{
+                Name:           decl.TagName,
+                Description:    decl.Summary,
+            }
+            if el.Description == "" {
+                el.Description = decl.Description
+            }
+            for _, attr := range decl.Attributes {
+                typ, values := manifestAttributeType(attr.Type)
+                el.Attributes = append(el.Attributes, CustomAttribute{
+                    Name:           attr.Name,
+                    Description:    attr.Description,
+                    Type:           typ,
+                    Values:         values,
+                })
+            }
+            for _, slot := range decl.Slots {
+                // the default slot takes children without a slot attribute
+                if slot.Name == "" {
+                    continue
+                }
+                el.Slots = append(el.Slots, CustomSlot{Name: slot.Name, Description: slot.Description})
+            }
+            for _, ev := range decl.Events {
+                if ev.Name == "" {
+                    continue
+                }
+                el.Events = append(el.Events, CustomEvent{Name: ev.Name, Description: ev.Description})
+            }
+            spec.Elements = append(spec.Elements, el)
+        }
+    }
+    return spec
+}
+
+var enumLiteral = regexp.MustCompile(`^(?:'([A-Za-z0-9_-]+)'|"([A-Za-z0-9_-]+)")$`)
+
+// manifestAttributeType maps the TypeScript type of an attribute to a type
+// of the custom element spec. Unions of string literals become enums, while
+// undefined and null are ignored as attributes can always be omitted.
+// Types which have no counterpart, e.g. unions of strings and numbers, are
+// treated as strings.
+func manifestAttributeType(t *ManifestType) (string, []string) {
+    if t == nil {
+        return "string", nil
+    }
+    var parts []string
+    for _, p := range strings.Split(t.Text, "|") {
+        p = strings.TrimSpace(p)
+        if p != "" && p != "undefined" && p != "null" {
+            parts = append(parts, p)
+        }
+    }
+    if len(parts) == 1 {
+        switch parts[0] {
+        case "boolean", "number":
+            return parts[0], nil
+        }
+    }
+    var values []string
+    for _, p := range parts {
+        m := enumLiteral.FindStringSubmatch(p)
+        if m == nil {
+            return "string", nil
+        }
+        values = append(values, m[1]+m[2])
+    }
+    if len(values) == 0 {
+        return "string", nil
+    }
+    return "enum", values
+}
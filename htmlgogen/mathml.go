@@ -0,0 +1,74 @@
+package main
+
+// mathmlTags lists the elements of MathML Core, see
+// https://www.w3.org/TR/mathml-core/#mathml-elements-and-attributes
+var mathmlTags []string = []string{
+    "annotation",
+    "annotation-xml",
+    "maction",
+    "math",
+    "merror",
+    "mfrac",
+    "mi",
+    "mmultiscripts",
+    "mn",
+    "mo",
+    "mover",
+    "mpadded",
+    "mphantom",
+    "mprescripts",
+    "mroot",
+    "mrow",
+    "ms",
+    "mspace",
+    "msqrt",
+    "mstyle",
+    "msub",
+    "msubsup",
+    "msup",
+    "mtable",
+    "mtd",
+    "mtext",
+    "mtr",
+    "munder",
+    "munderover",
+    "none",
+    "semantics",
+}
+
+// mathmlAttributes lists the attributes of MathML Core elements. Attributes
+// shared with HTML, e.g. id, class, style, dir and the event handlers, are
+// used from htmlgo/attributes.
+var mathmlAttributes []string = []string{
+    "accent",
+    "accentunder",
+    "actiontype",
+    "alttext",
+    "columnspan",
+    "depth",
+    "display",
+    "displaystyle",
+    "encoding",
+    "fence",
+    "form",
+    "height",
+    "largeop",
+    "linethickness",
+    "lspace",
+    "mathbackground",
+    "mathcolor",
+    "mathsize",
+    "mathvariant",
+    "maxsize",
+    "minsize",
+    "movablelimits",
+    "rowspan",
+    "rspace",
+    "scriptlevel",
+    "selection",
+    "separator",
+    "stretchy",
+    "symmetric",
+    "voffset",
+    "width",
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+    "encoding/json"
+    "fmt"
+    "os"
+)
+
+// Spec lists the elements and attributes to generate functions for. Names
+// listed in VoidElements are generated as void elements, whether they are
+// listed in Elements or ObsoleteElements.
+//
+//  {
+//      "elements": ["acme-card", "acme-divider"],
+//      "voidElements": ["acme-divider"],
+//      "attributes": ["variant"]
+//  }
+type Spec struct {
+    Elements            []string    `json:"elements"`
+    VoidElements        []string    `json:"voidElements"`
+    ObsoleteElements    []string    `json:"obsoleteElements"`
+    Attributes          []string    `json:"attributes"`
+    ObsoleteAttributes  []string    `json:"obsoleteAttributes"`
+    SvgElements         []string    `json:"svgElements"`
+    SvgAttributes       []string    `json:"svgAttributes"`
+    MathmlElements      []string    `json:"mathmlElements"`
+    MathmlAttributes    []string    `json:"mathmlAttributes"`
+}
+
+// DefaultSpec returns the built-in elements and attributes of the HTML
+// Living Standard, SVG 2 and MathML Core
+func DefaultSpec() Spec {
+    spec := Spec{
+        Elements:           tags,
+        ObsoleteElements:   obsoleteTags,
+        ObsoleteAttributes: obsoleteAttributes,
+        SvgElements:        svgTags,
+        SvgAttributes:      svgAttributes,
+        MathmlElements:     mathmlTags,
+        MathmlAttributes:   mathmlAttributes,
+    }
+    for _, list := range [][]string{tags, obsoleteTags} {
+        for _, tag := range list {
+            if _, ok := selfClosingTags[tag]; ok {
+                spec.VoidElements = append(spec.VoidElements, tag)
+            }
+        }
+    }
+    for _, list := range [][]string{attributes, eventHandlerAttributes, ariaAttributes} {
+        spec.Attributes = append(spec.Attributes, list...)
+    }
+    return spec
+}
+
+// LoadSpec reads a spec from a JSON file
+func LoadSpec(path string) (Spec, error) {
+    spec := Spec{}
+    b, err := os.ReadFile(path)
+    if err != nil {
+        return spec, err
+    }
+    if err := json.Unmarshal(b, &spec); err != nil {
+        return spec, fmt.Errorf("%s: %v", path, err)
+    }
+    return spec, nil
+}
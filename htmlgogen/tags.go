@@ -1,41 +1,37 @@
 package main
 
+// tags lists the elements of the WHATWG HTML Living Standard, see
+// https://html.spec.whatwg.org/multipage/indices.html#elements-3
 var tags []string = []string{
-    //"!DOCTYPE", implemented manually 
+    //"!DOCTYPE", implemented manually
     "a",
     "abbr",
-    "acronym",
     "address",
-    "applet",
     "area",
     "article",
     "aside",
     "audio",
     "b",
     "base",
-    "basefont",
     "bdi",
     "bdo",
-    "bgsound",
-    "big",
-    "blink",
     "blockquote",
     "body",
     "br",
     "button",
     "canvas",
     "caption",
-    "center",
     "cite",
     "code",
     "col",
     "colgroup",
+    "data",
     "datalist",
     "dd",
     "del",
     "details",
     "dfn",
-    "dir",
+    "dialog",
     "div",
     "dl",
     "dt",
@@ -44,11 +40,8 @@ var tags []string = []string{
     "fieldset",
     "figcaption",
     "figure",
-    "font",
     "footer",
     "form",
-    "frame",
-    "frameset",
     "h1",
     "h2",
     "h3",
@@ -65,24 +58,19 @@ var tags []string = []string{
     "img",
     "input",
     "ins",
-    "isindex",
     "kbd",
-    "keygen",
     "label",
     "legend",
     "li",
     "link",
-    "listing",
     "main",
     "map",
     "mark",
-    "marquee",
+    //"math", see package mathml
     "menu",
     "meta",
     "meter",
     "nav",
-    "nobr",
-    "noframes",
     "noscript",
     "object",
     "ol",
@@ -90,8 +78,7 @@ var tags []string = []string{
     "option",
     "output",
     "p",
-    "param",
-    "plaintext",
+    "picture",
     "pre",
     "progress",
     "q",
@@ -101,21 +88,23 @@ var tags []string = []string{
     "s",
     "samp",
     //"script", Implemented manually
+    "search",
     "section",
     "select",
+    "slot",
     "small",
     "source",
-    "spacer",
     "span",
-    "strike",
     "strong",
-    "style",
+    //"style", Implemented manually
     "sub",
     "summary",
     "sup",
+    //"svg", see package svg
     "table",
     "tbody",
     "td",
+    "template",
     "textarea",
     "tfoot",
     "th",
@@ -124,7 +113,6 @@ var tags []string = []string{
     "title",
     "tr",
     "track",
-    "tt",
     "u",
     "ul",
     "var",
@@ -132,6 +120,7 @@ var tags []string = []string{
     "wbr",
 }
 
+// selfClosingTags lists the void elements, which have no end tag
 var selfClosingTags map[string]struct{} = map[string]struct{}{
     "area":		struct{}{},
     "base":		struct{}{},
@@ -143,8 +132,49 @@ var selfClosingTags map[string]struct{} = map[string]struct{}{
     "input":		struct{}{},
     "link":		struct{}{},
     "meta":		struct{}{},
-    "param":		struct{}{},
     "source":		struct{}{},
     "track":		struct{}{},
     "wbr":		struct{}{},
+    // obsolete void elements
+    "basefont":		struct{}{},
+    "bgsound":		struct{}{},
+    "frame":		struct{}{},
+    "isindex":		struct{}{},
+    "keygen":		struct{}{},
+    "param":		struct{}{},
+}
+
+// obsoleteTags lists the elements the Living Standard marks as obsolete
+// and non-conforming. They are generated into the obsolete package, see
+// https://html.spec.whatwg.org/multipage/obsolete.html#non-conforming-features
+var obsoleteTags []string = []string{
+    "acronym",
+    "applet",
+    "basefont",
+    "bgsound",
+    "big",
+    "blink",
+    "center",
+    "dir",
+    "font",
+    "frame",
+    "frameset",
+    "isindex",
+    "keygen",
+    "listing",
+    "marquee",
+    "menuitem",
+    "multicol",
+    "nextid",
+    "nobr",
+    "noembed",
+    "noframes",
+    "param",
+    "plaintext",
+    "rb",
+    "rtc",
+    "spacer",
+    "strike",
+    "tt",
+    "xmp",
 }
@@ -33,6 +33,39 @@ func Dataset_(key, value string) Attribute {
     }
 }
 
+// Custom produces an attribute of any name, e.g. for XML vocabularies such
+// as Atom. Like other attributes, its value is escaped according to the
+// attribute's name, e.g. URLs are filtered for href. Names may consist of
+// letters, digits and _ : . -, otherwise the attribute is not rendered.
+func Custom(name string, data interface{}, templs ...string) Attribute {
+    attr := Attribute{ Data: data, Name: "Custom_" + name }
+    if !isCustomName(name) {
+        return attr
+    }
+    if len(templs) == 0 {
+        attr.Templ = `{{define "Custom_`+name+`"}}`+name+`="{{.}}"{{end}}`
+    } else {
+        attr.Templ = `{{define "Custom_`+name+`"}}`+name+`="` + strings.Join(templs, " ") + `"{{end}}`
+    }
+    return attr
+}
+
+func Custom_(name string, values ...string) Attribute {
+    return Custom(name, nil, values...)
+}
+
+func isCustomName(name string) bool {
+    for i, c := range name {
+        switch {
+        case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == ':':
+        case (c >= '0' && c <= '9' || c == '.' || c == '-') && i > 0:
+        default:
+            return false
+        }
+    }
+    return name != ""
+}
+
 // Begin of generated attributes
 [[ range .AttributeFuncs ]]
 
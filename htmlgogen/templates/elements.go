@@ -1,4 +1,4 @@
-package htmlgo
+package [[.Package]]
 
 import (
     "fmt"
@@ -18,27 +18,41 @@ type JS struct {
     data        interface{}
 }
 
+type CSS struct {
+    templ       string
+    data        interface{}
+}
+
+// WriteTo writes h to w, reformatted by the DefaultRenderer if it is set
 func WriteTo(w io.Writer, h HTML) {
+    if DefaultRenderer != nil {
+        DefaultRenderer.WriteTo(w, h)
+        return
+    }
     w.Write([]byte(h))
 }
 
+// voidTags lists the void elements, which have no end tag
+var voidTags = map[string]bool{
+[[- range .VoidElementFuncs ]]
+    "[[.TagName]]": true,
+[[- end ]]
+[[- range .ObsoleteVoidElementFuncs ]]
+    "[[.TagName]]": true,
+[[- end ]]
+}
+
 // Build a slice of type []Attribute for cosmetic purposes
 func Attr(attrs ...a.Attribute) []a.Attribute {
     return attrs
 }
 
-func prepareAttributes(attrs []a.Attribute) (string, string, map[string]interface{}) {
-    data := map[string]interface{}{}
-    templ := ""
-    defs := ""
-
-    for _, attr := range attrs {
-        data[attr.Name] = attr.Data
-        templ += ` {{template "` + attr.Name + `" .` + attr.Name + `}}`
-        defs += attr.Templ
+func renderAttributes(attrs []a.Attribute) string {
+    s := ""
+    for _, attr := range a.Render(attrs) {
+        s += " " + attr.String()
     }
-
-    return templ, defs, data
+    return s
 }
 
 func insertChildren(children ...HTML) string {
@@ -49,46 +63,165 @@ func insertChildren(children ...HTML) string {
     return s
 }
 
+// indent indents all lines of s, except within preformatted and escapable
+// raw text elements, whose text is kept as is
 func indent(s, indentation string) string {
-    return strings.Replace(s, "\n", "\n" + indentation, -1)
+    out := ""
+    for {
+        start, end := nextPreformatted(s)
+        if start < 0 {
+            break
+        }
+        out += strings.Replace(s[:start], "\n", "\n" + indentation, -1) + s[start:end]
+        s = s[end:]
+    }
+    return out + strings.Replace(s, "\n", "\n" + indentation, -1)
+}
+
+// startTag produces the start tag of an element, on a new line unless the
+// element is inline, where the line break would render as a space
+func startTag(tag string, attrs []a.Attribute) string {
+    s := "<" + tag + renderAttributes(attrs) + ">"
+    if inlineTags[tag] {
+        return s
+    }
+    return "\n" + s
 }
 
 func buildElement(tag string, attrs []a.Attribute, content string, close_ bool) string {
-    templ, defs, data := prepareAttributes(attrs)
-    complTempl := defs + "\n<" + tag + templ + ">" + content
+    s := startTag(tag, attrs) + content
     if close_ {
-        complTempl += "\n</" + tag +">"
+        if strings.Contains(content, "\n") {
+            s += "\n"
+        }
+        s += "</" + tag +">"
     }
-
-    t, _ := template.New(tag).Parse(complTempl)
-
-    buf := new(bytes.Buffer)
-    _ = t.Execute(buf, data)
-    return buf.String()
+    return s
 }
 
+// Element produces an element from its children. Children of block elements
+// are indented, while the children of inline and preformatted elements,
+// e.g. span and pre, are kept as is, as whitespace is significant there.
 func Element(tag string, attrs []a.Attribute, children ...HTML) HTML {
-    return HTML(buildElement(tag, attrs, 
-                indent(insertChildren(children...), "  "), true))
+    content := insertChildren(children...)
+    switch {
+    case preformattedTags[tag]:
+        // the parser drops a line break following the start tag
+        if strings.HasPrefix(content, "\n") {
+            content = "\n" + content
+        }
+        return HTML(startTag(tag, attrs) + content + "</" + tag + ">")
+    case inlineTags[tag]:
+        return HTML(startTag(tag, attrs) + content + "</" + tag + ">")
+    case rawTextTags[tag]:
+        // raw text contains no elements, even if it looks like it does
+        return HTML(buildElement(tag, attrs,
+                    strings.Replace(content, "\n", "\n  ", -1), true))
+    }
+    return HTML(buildElement(tag, attrs, indent(content, "  "), true))
 }
 
 func VoidElement(tag string, attrs []a.Attribute) HTML {
     return HTML(buildElement(tag, attrs, "", false))
 }
 
+// ForeignElement produces an element of SVG or MathML. Unlike HTML elements,
+// foreign elements without children are self-closing, e.g. <circle r="1"/>.
+func ForeignElement(tag string, attrs []a.Attribute, children ...HTML) HTML {
+    content := insertChildren(children...)
+    if content == "" {
+        return HTML("\n<" + tag + renderAttributes(attrs) + "/>")
+    }
+    if !strings.Contains(content, "\n") {
+        return HTML("\n<" + tag + renderAttributes(attrs) + ">" + content + "</" + tag + ">")
+    }
+    return HTML("\n<" + tag + renderAttributes(attrs) + ">" + indent(content, "  ") + "\n</" + tag + ">")
+}
+
 // Produce HTML from plain text by escaping
 func Text(v interface{}) HTML {
-    return HTML("\n" + html.EscapeString(fmt.Sprint(v)))
+    return HTML(html.EscapeString(fmt.Sprint(v)))
 }
 
 func Text_(s string) HTML {
     return HTML(s)
 }
 
+// Comment produces an HTML comment. Sequences which would end the comment
+// early, such as -->, are broken up by inserting spaces between hyphens,
+// and text which would read as a marker of HeadOnce or Once is indented.
+func Comment(text string) HTML {
+    return HTML("\n<!--" + escapeComment(text) + "-->")
+}
+
+func escapeComment(s string) string {
+    for strings.Contains(s, "--") {
+        s = strings.Replace(s, "--", "- -", -1)
+    }
+    if strings.HasPrefix(s, ">") || strings.HasPrefix(s, "-") ||
+        strings.HasPrefix(s, "htmlgo:") || strings.HasPrefix(s, "/htmlgo:") {
+        s = " " + s
+    }
+    if strings.HasSuffix(s, "-") {
+        s += " "
+    }
+    return s
+}
+
+// ConditionalComment produces a downlevel-hidden conditional comment, whose
+// children are only rendered by clients evaluating the condition, e.g.
+// Outlook for the condition "mso". Other clients treat the children as a
+// comment, so they must not contain comments themselves. Conditions may
+// consist of letters, digits, spaces, dots and the operators ! ( ) & |,
+// otherwise nothing is rendered.
+func ConditionalComment(condition string, children ...HTML) HTML {
+    if !isCondition(condition) {
+        return ""
+    }
+    return HTML("\n<!--[if " + condition + "]>" +
+                insertChildren(children...) + "\n<![endif]-->")
+}
+
+// RevealedConditionalComment produces a downlevel-revealed conditional
+// comment, whose children are rendered by all clients except those not
+// evaluating the condition, e.g. "!mso" hides them from Outlook
+func RevealedConditionalComment(condition string, children ...HTML) HTML {
+    if !isCondition(condition) {
+        return ""
+    }
+    return HTML("\n<!--[if " + condition + "]><!-->" +
+                insertChildren(children...) + "\n<!--<![endif]-->")
+}
+
+func isCondition(s string) bool {
+    if strings.TrimSpace(s) == "" {
+        return false
+    }
+    for _, r := range s {
+        switch {
+        case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+        case strings.ContainsRune(" !()&|.", r):
+        default:
+            return false
+        }
+    }
+    return true
+}
+
+// CDATA produces a CDATA section, which is only allowed in foreign content,
+// i.e. in SVG and MathML elements. Occurrences of ]]> are split across two
+// sections.
+func CDATA(text string) HTML {
+    return HTML("\n<![CDATA[" +
+                strings.Replace(text, "]]>", "]]]]><![CDATA[>", -1) + "]]>")
+}
+
 // Begin of manually defined elements
 
+// Html5 produces a complete HTML5 document, moving content marked by
+// HeadOnce into the head element, see Collect
 func Html5(attrs []a.Attribute, children ...HTML) HTML {
-    return DoctypeHtml5 + Html(attrs, children...)
+    return Collect(DoctypeHtml5 + Html(attrs, children...))
 }
 
 func Html5_(children ...HTML) HTML {
@@ -101,27 +234,81 @@ func Doctype(t string) HTML {
 
 const DoctypeHtml5 HTML = "<!DOCTYPE HTML>"
 
-func Script(attrs []a.Attribute, js JS) HTML {
-    if js.data == nil {
-        return Element("script", attrs, HTML("\n" + js.templ))
+// Build an element with raw text content, i.e. script or style, from a
+// template, which is executed with data using html/template to escape data
+// according to the element's context
+func rawTextElement(tag string, attrs []a.Attribute, templ string, data interface{}) HTML {
+    if data == nil {
+        return Element(tag, attrs, HTML("\n" + templ))
     }
 
-    complTempl := buildElement("script", attrs,
-                               indent("\n" + js.templ, "  "), true)
-    
+    // The start tag is part of the template, as its attributes, e.g. the
+    // type of a script, determine the escaping of the contents
+    start := "<" + tag + renderAttributes(attrs) + ">"
+    end := "</" + tag + ">"
+
     // TODO set verbosity level to enable logging
-    t, err := template.New("_").Delims("{%$", "$%}").Parse(complTempl)
+    t, err := template.New("_").Delims("{%$", "$%}").Parse(start + templ + end)
     if err != nil {
-        return Element("script", attrs)
+        return Element(tag, attrs)
     }
 
     buf := new(bytes.Buffer)
-    err = t.Execute(buf, js.data)
+    err = t.Execute(buf, data)
     if err != nil {
-        return Element("script", attrs)
+        return Element(tag, attrs)
     }
 
-    return HTML(buf.String())
+    content := strings.TrimSuffix(strings.TrimPrefix(buf.String(), start), end)
+    return Element(tag, attrs, HTML("\n" + content))
+}
+
+// Build an element with escapable raw text content, i.e. textarea or title,
+// which cannot contain elements. The text is written as is, without
+// indentation. Carriage returns are kept as character references, as the
+// parser would normalise them.
+func escapableRawTextElement(tag string, attrs []a.Attribute, text string) HTML {
+    text = strings.Replace(html.EscapeString(text), "\r", "&#13;", -1)
+    if preformattedTags[tag] && strings.HasPrefix(text, "\n") {
+        // the parser drops a line break following the start tag
+        text = "\n" + text
+    }
+    return HTML(startTag(tag, attrs) + text + "</" + tag + ">")
+}
+
+// TextareaText produces a textarea element, whose initial value is text
+func TextareaText(attrs []a.Attribute, text string) HTML {
+    return escapableRawTextElement("textarea", attrs, text)
+}
+
+func TextareaText_(text string) HTML {
+    return TextareaText(Attr(), text)
+}
+
+// TitleText produces the title element of a document from plain text
+func TitleText(attrs []a.Attribute, text string) HTML {
+    return escapableRawTextElement("title", attrs, text)
+}
+
+func TitleText_(text string) HTML {
+    return TitleText(Attr(), text)
+}
+
+// Join templates by newlines, replacing the delimiters {{ and }} by
+// {%$ and $%}, which do not clash with the attribute templates
+func rawTextTemplate(templs []string) string {
+    if len(templs) == 0 {
+        return "{%$.$%}"
+    }
+    return strings.Replace(
+             strings.Replace(
+                strings.Join(templs, "\n"),
+                "{{", "{%$", -1),
+             "}}", "$%}", -1)
+}
+
+func Script(attrs []a.Attribute, js JS) HTML {
+    return rawTextElement("script", attrs, js.templ, js.data)
 }
 
 func Script_(js JS) HTML {
@@ -129,23 +316,34 @@ func Script_(js JS) HTML {
 }
 
 func JavaScript(data interface{}, templs ...string) JS {
-    js := JS{ data: data }
-    if len(templs) == 0 {
-        js.templ = "{%$.$%}"
-    } else {
-        js.templ = strings.Replace(
-                     strings.Replace(
-                        strings.Join(templs, "\n"),
-                        "{{", "{%$", -1),
-                     "}}", "$%}", -1)
-    }
-    return js
+    return JS{ data: data, templ: rawTextTemplate(templs) }
 }
 
 func JavaScript_(templs ...string) JS {
     return JavaScript(nil, templs...)
 }
 
+// Style produces a style element. Unlike children of other elements, the
+// stylesheet is not HTML-escaped, so selectors such as ul > li are kept,
+// while the data of the stylesheet is escaped for CSS.
+func Style(attrs []a.Attribute, css CSS) HTML {
+    return rawTextElement("style", attrs, css.templ, css.data)
+}
+
+func Style_(css CSS) HTML {
+    return Style(Attr(), css)
+}
+
+// Stylesheet produces the contents of a style element, placing data into
+// the given templates like JavaScript
+func Stylesheet(data interface{}, templs ...string) CSS {
+    return CSS{ data: data, templ: rawTextTemplate(templs) }
+}
+
+func Stylesheet_(templs ...string) CSS {
+    return Stylesheet(nil, templs...)
+}
+
 // Begin of generated elements
 
 [[ range .ElementFuncs ]]
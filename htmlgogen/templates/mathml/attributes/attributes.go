@@ -0,0 +1,28 @@
+// Package attributes provides the attributes of MathML Core elements.
+// Attributes shared with HTML, such as id, class, style and dir, are
+// provided by htmlgo/attributes.
+package attributes
+
+import (
+    "strings"
+
+    a "github.com/julvo/htmlgo/attributes"
+)
+
+// Begin of generated attributes
+[[ range .MathmlAttributeFuncs ]]
+
+func [[.FuncName]](data interface{}, templs ...string) a.Attribute {
+    attr := a.Attribute{ Data: data, Name: "[[.FuncName]]" }
+    if len(templs) == 0 {
+        attr.Templ = `{{define "[[.FuncName]]"}}[[.AttrName]]="{{.}}"{{end}}`
+    } else {
+        attr.Templ = `{{define "[[.FuncName]]"}}[[.AttrName]]="` + strings.Join(templs, " ") + `"{{end}}`
+    }
+    return attr
+}
+
+func [[.FuncName]]_(values ...string) a.Attribute {
+    return [[.FuncName]](nil, values...)
+}
+[[ end ]]
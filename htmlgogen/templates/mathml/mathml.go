@@ -0,0 +1,26 @@
+// Package mathml provides the elements of MathML Core. The elements follow
+// the rules of foreign content, i.e. elements without children are
+// self-closing:
+//
+//  Math(h.Attr(ma.Display_("block")),
+//      Mfrac_(Mi_(h.Text("a")), Mn_(h.Text("2"))))
+//
+// Use the attributes of htmlgo/mathml/attributes along with the global
+// ones of htmlgo/attributes.
+package mathml
+
+import (
+    h "github.com/julvo/htmlgo"
+    a "github.com/julvo/htmlgo/attributes"
+)
+
+// Begin of generated elements
+[[ range .MathmlElementFuncs ]]
+func [[.FuncName]](attrs []a.Attribute, children ...h.HTML) h.HTML {
+    return h.ForeignElement("[[.TagName]]", attrs, children...)
+}
+
+func [[.FuncName]]_(children ...h.HTML) h.HTML {
+    return [[.FuncName]](h.Attr(), children...)
+}
+[[ end ]]
@@ -0,0 +1,27 @@
+// Package attributes provides the presentational attributes which the HTML
+// Living Standard marks as obsolete. Prefer CSS for new code.
+package attributes
+
+import (
+    "strings"
+
+    a "github.com/julvo/htmlgo/attributes"
+)
+
+// Begin of generated attributes
+[[ range .ObsoleteAttributeFuncs ]]
+
+func [[.FuncName]](data interface{}, templs ...string) a.Attribute {
+    attr := a.Attribute{ Data: data, Name: "[[.FuncName]]" }
+    if len(templs) == 0 {
+        attr.Templ = `{{define "[[.FuncName]]"}}[[.AttrName]]="{{.}}"{{end}}`
+    } else {
+        attr.Templ = `{{define "[[.FuncName]]"}}[[.AttrName]]="` + strings.Join(templs, " ") + `"{{end}}`
+    }
+    return attr
+}
+
+func [[.FuncName]]_(values ...string) a.Attribute {
+    return [[.FuncName]](nil, values...)
+}
+[[ end ]]
@@ -0,0 +1,32 @@
+// Package obsolete provides the elements which the HTML Living Standard
+// marks as obsolete and non-conforming. They are kept apart from htmlgo so
+// new code does not use them by accident.
+package obsolete
+
+import (
+    h "github.com/julvo/htmlgo"
+    a "github.com/julvo/htmlgo/attributes"
+)
+
+// Begin of generated elements
+
+[[ range .ObsoleteElementFuncs ]]
+func [[.FuncName]](attrs []a.Attribute, children ...h.HTML) h.HTML {
+    return h.Element("[[.TagName]]", attrs, children...)
+}
+
+func [[.FuncName]]_(children ...h.HTML) h.HTML {
+    return [[.FuncName]](h.Attr(), children...)
+}
+[[ end ]]
+
+// Begin of generated void elements
+
+[[ range .ObsoleteVoidElementFuncs ]]
+func [[.FuncName]](attrs []a.Attribute) h.HTML {
+    return h.VoidElement("[[.TagName]]", attrs)
+}
+func [[.FuncName]]_() h.HTML {
+    return [[.FuncName]](h.Attr())
+}
+[[ end ]]
@@ -0,0 +1,117 @@
+// Package attributes provides the attributes of SVG 2 elements, including
+// the presentation attributes. Attributes shared with HTML, such as id,
+// class, style and aria-*, are provided by htmlgo/attributes.
+//
+// The data of paint and reference attributes, i.e. fill, stroke, clip-path,
+// mask, filter and marker-*, is filtered like html/template filters URLs:
+// strings may only contain colors, keywords and references to fragments of
+// the document, e.g. url(#gradient). Other strings are replaced by
+// ZgotmplZ. Likewise, attributeName must not name an attribute which would
+// be filtered, e.g. href or an event handler.
+package attributes
+
+import (
+    "fmt"
+    "reflect"
+    "strings"
+
+    a "github.com/julvo/htmlgo/attributes"
+)
+
+// unsafeValue replaces filtered data, as html/template does
+const unsafeValue = "ZgotmplZ"
+
+// filterPaint filters string data which is not a paint or reference value
+func filterPaint(data interface{}) interface{} {
+    s, ok := stringData(data)
+    if !ok || isPaint(s) {
+        return data
+    }
+    return unsafeValue
+}
+
+// stringData returns data as html/template prints it if it is printed as
+// string, i.e. if it is of a string type, a fmt.Stringer or an error, or a
+// pointer to one
+func stringData(data interface{}) (string, bool) {
+    v := reflect.ValueOf(data)
+    for v.Kind() == reflect.Ptr && !v.IsNil() {
+        if _, ok := v.Interface().(fmt.Stringer); ok {
+            break
+        }
+        if _, ok := v.Interface().(error); ok {
+            break
+        }
+        v = v.Elem()
+    }
+    if !v.IsValid() {
+        return "", false
+    }
+    switch d := v.Interface().(type) {
+    case fmt.Stringer, error:
+        return fmt.Sprint(d), true
+    }
+    if v.Kind() == reflect.String {
+        return v.String(), true
+    }
+    return "", false
+}
+
+// isPaint reports whether s consists of colors, keywords, numbers and
+// references to fragments, e.g. url(#gradient) red
+func isPaint(s string) bool {
+    for _, c := range s {
+        switch {
+        case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+        case strings.ContainsRune(" #%.,()-_+", c):
+        default:
+            return false
+        }
+    }
+    lower := strings.ToLower(s)
+    for i := strings.Index(lower, "url("); i >= 0; i = strings.Index(lower, "url(") {
+        if !strings.HasPrefix(lower[i+len("url("):], "#") {
+            return false
+        }
+        lower = lower[i+len("url("):]
+    }
+    return true
+}
+
+// filterAttributeName filters animations of attributes whose values
+// html/template would filter, as the animated values are not filtered
+func filterAttributeName(data interface{}) interface{} {
+    s, ok := stringData(data)
+    if !ok {
+        return data
+    }
+    name := strings.ToLower(strings.TrimSpace(s))
+    if i := strings.IndexByte(name, ':'); i >= 0 {
+        name = name[i+1:]
+    }
+    if name == "href" || name == "src" || name == "style" || strings.HasPrefix(name, "on") {
+        return unsafeValue
+    }
+    return s
+}
+
+// Begin of generated attributes
+[[ range .SvgAttributeFuncs ]]
+
+func [[.FuncName]](data interface{}, templs ...string) a.Attribute {
+    [[- if .Filter ]]
+    data = [[.Filter]](data)
+    [[- end ]]
+    attr := a.Attribute{ Data: data, Name: "[[.FuncName]]" }
+    if len(templs) == 0 {
+        attr.Templ = `{{define "[[.FuncName]]"}}[[.AttrName]]="{{.}}"{{end}}`
+    } else {
+        attr.Templ = `{{define "[[.FuncName]]"}}[[.AttrName]]="` + strings.Join(templs, " ") + `"{{end}}`
+    }
+    return attr
+}
+
+func [[.FuncName]]_(values ...string) a.Attribute {
+    return [[.FuncName]](nil, values...)
+}
+[[ end ]]
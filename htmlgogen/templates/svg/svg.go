@@ -0,0 +1,28 @@
+// Package svg provides the elements of SVG 2 for inline SVG. The elements
+// follow the rules of foreign content, i.e. elements without children are
+// self-closing, and names are case-sensitive, e.g. LinearGradient produces
+// <linearGradient>. Use the attributes of htmlgo/svg/attributes along with
+// the global ones of htmlgo/attributes:
+//
+//  Svg(h.Attr(sa.ViewBox_("0 0 10 10"), a.Class_("icon")),
+//      Circle(h.Attr(sa.Cx_("5"), sa.Cy_("5"), sa.R_("4"), sa.Fill(color))))
+//
+// The script and style elements of SVG are not provided, use htmlgo's
+// Script and Style instead.
+package svg
+
+import (
+    h "github.com/julvo/htmlgo"
+    a "github.com/julvo/htmlgo/attributes"
+)
+
+// Begin of generated elements
+[[ range .SvgElementFuncs ]]
+func [[.FuncName]](attrs []a.Attribute, children ...h.HTML) h.HTML {
+    return h.ForeignElement("[[.TagName]]", attrs, children...)
+}
+
+func [[.FuncName]]_(children ...h.HTML) h.HTML {
+    return [[.FuncName]](h.Attr(), children...)
+}
+[[ end ]]
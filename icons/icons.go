@@ -0,0 +1,429 @@
+// Package icons renders SVG icons loaded from a directory, e.g. of an
+// embed.FS, either inline or as references into a sprite of symbols:
+//
+//	//go:embed icons/*.svg
+//	var iconFiles embed.FS
+//
+//	var set = icons.MustLoad(iconFiles, "icons")
+//
+//	set.Inline("search", h.Attr(a.Class_("icon"), a.Width_("16"), a.Height_("16")))
+//	set.Use("search", h.Attr(a.Title("Search")))
+//
+// Icons are validated and normalised when loading: editor metadata,
+// scripts, styles, event handlers, foreign content and links to other
+// documents are removed, and ids are prefixed with the icon's id, so that
+// icons can be inlined next to each other. Untitled icons are hidden from
+// screen readers, while an icon with a title attribute gets role img and a
+// <title> element.
+package icons
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	h "github.com/julvo/htmlgo"
+	a "github.com/julvo/htmlgo/attributes"
+	"github.com/julvo/htmlgo/svg"
+	sa "github.com/julvo/htmlgo/svg/attributes"
+)
+
+// Icon is a normalised SVG icon
+type Icon struct {
+	name  string
+	id    string
+	attrs []a.Attribute
+	body  h.HTML
+}
+
+// Set is a set of icons, by file name without the .svg extension
+type Set struct {
+	icons map[string]*Icon
+}
+
+var iconName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Load reads all .svg files of dir. Names of the files must consist of
+// lowercase letters, digits, hyphens and underscores.
+func Load(fsys fs.FS, dir string) (*Set, error) {
+	paths, err := fs.Glob(fsys, path.Join(dir, "*.svg"))
+	if err != nil {
+		return nil, err
+	}
+	set := &Set{icons: map[string]*Icon{}}
+	for _, p := range paths {
+		name := strings.TrimSuffix(path.Base(p), ".svg")
+		if !iconName.MatchString(name) {
+			return nil, fmt.Errorf("icons: invalid icon name %q", name)
+		}
+		b, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return nil, err
+		}
+		icon, err := Parse(name, b)
+		if err != nil {
+			return nil, fmt.Errorf("icons: %s: %v", p, err)
+		}
+		set.icons[name] = icon
+	}
+	return set, nil
+}
+
+// MustLoad is like Load but panics on errors. It is intended for
+// package-level variables.
+func MustLoad(fsys fs.FS, dir string) *Set {
+	set, err := Load(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return set
+}
+
+// Names returns the names of the icons in sorted order
+func (s *Set) Names() []string {
+	names := make([]string, 0, len(s.icons))
+	for name := range s.icons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Icon returns the icon of the given name
+func (s *Set) Icon(name string) (*Icon, bool) {
+	icon, ok := s.icons[name]
+	return icon, ok
+}
+
+// Inline renders the icon as svg element. Unknown icons render nothing.
+func (s *Set) Inline(name string, attrs []a.Attribute) h.HTML {
+	icon, ok := s.icons[name]
+	if !ok {
+		return ""
+	}
+	return icon.Inline(attrs)
+}
+
+func (s *Set) Inline_(name string) h.HTML {
+	return s.Inline(name, h.Attr())
+}
+
+// Use renders an svg element referencing the icon's symbol. The symbol is
+// included using htmlgo.Once, so that it is rendered once per page, unless
+// it is part of a sprite rendered before. Unknown icons render nothing.
+func (s *Set) Use(name string, attrs []a.Attribute) h.HTML {
+	icon, ok := s.icons[name]
+	if !ok {
+		return ""
+	}
+	return h.Once("icon:"+icon.id, sprite(icon.Symbol())) + icon.Use(attrs)
+}
+
+func (s *Set) Use_(name string) h.HTML {
+	return s.Use(name, h.Attr())
+}
+
+// Sprite renders a hidden svg element with the symbols of the given icons,
+// or of all icons if no names are given. Symbols which are already part of
+// the page are dropped by htmlgo.Collect.
+func (s *Set) Sprite(names ...string) h.HTML {
+	if len(names) == 0 {
+		names = s.Names()
+	}
+	symbols := []h.HTML{}
+	for _, name := range names {
+		if icon, ok := s.icons[name]; ok {
+			symbols = append(symbols, h.Once("icon:"+icon.id, icon.Symbol()))
+		}
+	}
+	return sprite(symbols...)
+}
+
+// sprite wraps symbols into a hidden svg element
+func sprite(symbols ...h.HTML) h.HTML {
+	return svg.Svg(h.Attr(sa.Width_("0"), sa.Height_("0"),
+		a.Style_("position:absolute;overflow:hidden"), a.AriaHidden_("true")), symbols...)
+}
+
+// ID returns the id of the icon's symbol
+func (i *Icon) ID() string {
+	return i.id
+}
+
+// Inline renders the icon as svg element
+func (i *Icon) Inline(attrs []a.Attribute) h.HTML {
+	attrs, title := accessible(attrs)
+	root := append(append([]a.Attribute{}, i.attrs...), attrs...)
+	return svg.Svg(root, title, i.body)
+}
+
+// Symbol renders the icon as symbol, to be referenced by Use
+func (i *Icon) Symbol() h.HTML {
+	return svg.Symbol(append(h.Attr(a.Id(i.id)), i.attrs...), i.body)
+}
+
+// Use renders an svg element referencing the icon's symbol, which must be
+// part of the page, e.g. by rendering Symbol or a sprite
+func (i *Icon) Use(attrs []a.Attribute) h.HTML {
+	attrs, title := accessible(attrs)
+	return svg.Svg(attrs, title, svg.Use(h.Attr(sa.Href("#"+i.id))))
+}
+
+// accessible turns a title attribute into a title element with role img, or
+// hides the icon from screen readers if there is no title
+func accessible(attrs []a.Attribute) ([]a.Attribute, h.HTML) {
+	rest := []a.Attribute{}
+	title := ""
+	for _, attr := range attrs {
+		if attr.Name != "Title" {
+			rest = append(rest, attr)
+			continue
+		}
+		for _, r := range a.Render([]a.Attribute{attr}) {
+			title = html.UnescapeString(r.Value)
+		}
+	}
+	if title == "" {
+		return append(h.Attr(a.AriaHidden_("true"), attribute("focusable", "false")), rest...), ""
+	}
+	return append(h.Attr(a.Role_("img")), rest...), svg.Title_(h.Text(title))
+}
+
+const svgNS = "http://www.w3.org/2000/svg"
+
+// elements lists the elements kept when normalising icons. The children of
+// links are kept, while other elements are dropped along with their
+// children.
+var elements = map[string]bool{
+	"circle": true, "clipPath": true, "defs": true, "ellipse": true,
+	"feBlend": true, "feColorMatrix": true, "feComposite": true, "feFlood": true,
+	"feGaussianBlur": true, "feMerge": true, "feMergeNode": true, "feOffset": true,
+	"filter": true, "g": true, "line": true, "linearGradient": true, "marker": true,
+	"mask": true, "path": true, "pattern": true, "polygon": true, "polyline": true,
+	"radialGradient": true, "rect": true, "stop": true, "text": true, "tspan": true,
+	"use": true,
+}
+
+// rootAttributes are dropped from the root element, as they are set by the
+// icon's user or would be duplicated
+var rootAttributes = map[string]bool{
+	"width": true, "height": true, "x": true, "y": true, "id": true,
+	"class": true, "version": true, "baseProfile": true, "enable-background": true,
+	"role": true, "focusable": true, "aria-hidden": true, "aria-label": true,
+}
+
+type node struct {
+	name     string
+	attrs    [][2]string
+	text     string
+	children []*node
+}
+
+// Parse validates and normalises the SVG source of an icon
+func Parse(name string, src []byte) (*Icon, error) {
+	root, err := parseTree(src)
+	if err != nil {
+		return nil, err
+	}
+	id := "icon-" + name
+
+	ids := map[string]bool{}
+	collectIDs(root, ids)
+
+	icon := &Icon{name: name, id: id}
+	viewBox, width, height := "", "", ""
+	for _, attr := range normaliseAttrs(root.attrs, id, ids) {
+		switch {
+		case attr[0] == "viewBox":
+			viewBox = attr[1]
+		case attr[0] == "width":
+			width = attr[1]
+		case attr[0] == "height":
+			height = attr[1]
+		case !rootAttributes[attr[0]]:
+			icon.attrs = append(icon.attrs, attribute(attr[0], attr[1]))
+		}
+	}
+	if viewBox == "" {
+		if !isNumber(width) || !isNumber(height) {
+			return nil, fmt.Errorf("missing viewBox")
+		}
+		viewBox = "0 0 " + strings.TrimSuffix(width, "px") + " " + strings.TrimSuffix(height, "px")
+	}
+	icon.attrs = append([]a.Attribute{sa.ViewBox(viewBox)}, icon.attrs...)
+
+	for _, child := range root.children {
+		icon.body += render(child, id, ids)
+	}
+	return icon, nil
+}
+
+// parseTree parses the source into a tree of elements of the SVG namespace
+func parseTree(src []byte) (*node, error) {
+	dec := xml.NewDecoder(bytes.NewReader(src))
+	var root *node
+	stack := []*node{}
+	// depth of an element which is dropped along with its children
+	skip := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if skip > 0 {
+				skip++
+				continue
+			}
+			if t.Name.Space != svgNS && t.Name.Space != "" {
+				skip = 1
+				continue
+			}
+			n := &node{name: t.Name.Local}
+			for _, attr := range t.Attr {
+				if name, ok := attrName(attr.Name); ok {
+					n.attrs = append(n.attrs, [2]string{name, attr.Value})
+				}
+			}
+			if root == nil {
+				if n.name != "svg" {
+					return nil, fmt.Errorf("root element is %s, not svg", n.name)
+				}
+				root = n
+			} else if len(stack) == 0 {
+				return nil, fmt.Errorf("content after the root element")
+			} else if n.name == "a" {
+				// keep the children of links, dropping the link
+				n = stack[len(stack)-1]
+			} else if !elements[n.name] {
+				skip = 1
+				continue
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if skip == 0 && len(stack) > 0 && strings.TrimSpace(string(t)) != "" {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, &node{text: strings.TrimSpace(string(t))})
+			}
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("no svg element")
+	}
+	return root, nil
+}
+
+// attrName returns the name of an attribute in the SVG namespace, mapping
+// xlink:href to href, and drops other namespaces and event handlers
+func attrName(name xml.Name) (string, bool) {
+	switch name.Space {
+	case "":
+	case "http://www.w3.org/1999/xlink":
+		if name.Local != "href" {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+	if name.Local == "xmlns" || strings.HasPrefix(strings.ToLower(name.Local), "on") {
+		return "", false
+	}
+	return name.Local, true
+}
+
+func collectIDs(n *node, ids map[string]bool) {
+	for _, attr := range n.attrs {
+		if attr[0] == "id" {
+			ids[attr[1]] = true
+		}
+	}
+	for _, child := range n.children {
+		collectIDs(child, ids)
+	}
+}
+
+var reference = regexp.MustCompile(`url\(\s*['"]?#([^)'"\s]+)['"]?\s*\)`)
+
+// normaliseAttrs prefixes ids and references to them, and drops links to
+// other documents
+func normaliseAttrs(attrs [][2]string, prefix string, ids map[string]bool) [][2]string {
+	out := [][2]string{}
+	for _, attr := range attrs {
+		name, value := attr[0], attr[1]
+		switch {
+		case name == "id":
+			value = prefix + "-" + value
+		case name == "href":
+			if !strings.HasPrefix(value, "#") || !ids[value[1:]] {
+				continue
+			}
+			value = "#" + prefix + "-" + value[1:]
+		default:
+			value = reference.ReplaceAllStringFunc(value, func(ref string) string {
+				id := reference.FindStringSubmatch(ref)[1]
+				if !ids[id] {
+					return ref
+				}
+				return "url(#" + prefix + "-" + id + ")"
+			})
+		}
+		out = append(out, [2]string{name, value})
+	}
+	return out
+}
+
+func render(n *node, prefix string, ids map[string]bool) h.HTML {
+	if n.name == "" {
+		return h.Text(n.text)
+	}
+	attrs := []a.Attribute{}
+	hasHref := false
+	for _, attr := range normaliseAttrs(n.attrs, prefix, ids) {
+		attrs = append(attrs, attribute(attr[0], attr[1]))
+		hasHref = hasHref || attr[0] == "href"
+	}
+	if n.name == "use" && !hasHref {
+		return ""
+	}
+	children := []h.HTML{}
+	for _, child := range n.children {
+		children = append(children, render(child, prefix, ids))
+	}
+	return h.ForeignElement(n.name, attrs, children...)
+}
+
+// attribute creates an attribute whose value is escaped according to its
+// name, e.g. as CSS for style
+func attribute(name, value string) a.Attribute {
+	return a.Attribute{
+		Data:  value,
+		Templ: `{{define "Icon"}}` + name + `="{{.}}"{{end}}`,
+		Name:  "Icon",
+	}
+}
+
+var number = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(px)?$`)
+
+func isNumber(s string) bool {
+	return number.MatchString(s)
+}
@@ -0,0 +1,446 @@
+// Package attributes provides the attributes of MathML Core elements.
+// Attributes shared with HTML, such as id, class, style and dir, are
+// provided by htmlgo/attributes.
+package attributes
+
+import (
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// Begin of generated attributes
+
+func Accent(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Accent"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Accent"}}accent="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Accent"}}accent="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Accent_(values ...string) a.Attribute {
+	return Accent(nil, values...)
+}
+
+func Accentunder(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Accentunder"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Accentunder"}}accentunder="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Accentunder"}}accentunder="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Accentunder_(values ...string) a.Attribute {
+	return Accentunder(nil, values...)
+}
+
+func Actiontype(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Actiontype"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Actiontype"}}actiontype="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Actiontype"}}actiontype="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Actiontype_(values ...string) a.Attribute {
+	return Actiontype(nil, values...)
+}
+
+func Alttext(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Alttext"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Alttext"}}alttext="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Alttext"}}alttext="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Alttext_(values ...string) a.Attribute {
+	return Alttext(nil, values...)
+}
+
+func Columnspan(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Columnspan"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Columnspan"}}columnspan="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Columnspan"}}columnspan="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Columnspan_(values ...string) a.Attribute {
+	return Columnspan(nil, values...)
+}
+
+func Depth(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Depth"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Depth"}}depth="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Depth"}}depth="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Depth_(values ...string) a.Attribute {
+	return Depth(nil, values...)
+}
+
+func Display(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Display"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Display"}}display="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Display"}}display="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Display_(values ...string) a.Attribute {
+	return Display(nil, values...)
+}
+
+func Displaystyle(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Displaystyle"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Displaystyle"}}displaystyle="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Displaystyle"}}displaystyle="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Displaystyle_(values ...string) a.Attribute {
+	return Displaystyle(nil, values...)
+}
+
+func Encoding(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Encoding"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Encoding"}}encoding="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Encoding"}}encoding="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Encoding_(values ...string) a.Attribute {
+	return Encoding(nil, values...)
+}
+
+func Fence(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Fence"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Fence"}}fence="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Fence"}}fence="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Fence_(values ...string) a.Attribute {
+	return Fence(nil, values...)
+}
+
+func Form(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Form"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Form"}}form="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Form"}}form="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Form_(values ...string) a.Attribute {
+	return Form(nil, values...)
+}
+
+func Height(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Height"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Height"}}height="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Height"}}height="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Height_(values ...string) a.Attribute {
+	return Height(nil, values...)
+}
+
+func Largeop(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Largeop"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Largeop"}}largeop="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Largeop"}}largeop="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Largeop_(values ...string) a.Attribute {
+	return Largeop(nil, values...)
+}
+
+func Linethickness(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Linethickness"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Linethickness"}}linethickness="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Linethickness"}}linethickness="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Linethickness_(values ...string) a.Attribute {
+	return Linethickness(nil, values...)
+}
+
+func Lspace(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Lspace"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Lspace"}}lspace="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Lspace"}}lspace="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Lspace_(values ...string) a.Attribute {
+	return Lspace(nil, values...)
+}
+
+func Mathbackground(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Mathbackground"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Mathbackground"}}mathbackground="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Mathbackground"}}mathbackground="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Mathbackground_(values ...string) a.Attribute {
+	return Mathbackground(nil, values...)
+}
+
+func Mathcolor(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Mathcolor"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Mathcolor"}}mathcolor="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Mathcolor"}}mathcolor="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Mathcolor_(values ...string) a.Attribute {
+	return Mathcolor(nil, values...)
+}
+
+func Mathsize(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Mathsize"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Mathsize"}}mathsize="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Mathsize"}}mathsize="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Mathsize_(values ...string) a.Attribute {
+	return Mathsize(nil, values...)
+}
+
+func Mathvariant(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Mathvariant"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Mathvariant"}}mathvariant="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Mathvariant"}}mathvariant="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Mathvariant_(values ...string) a.Attribute {
+	return Mathvariant(nil, values...)
+}
+
+func Maxsize(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Maxsize"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Maxsize"}}maxsize="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Maxsize"}}maxsize="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Maxsize_(values ...string) a.Attribute {
+	return Maxsize(nil, values...)
+}
+
+func Minsize(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Minsize"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Minsize"}}minsize="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Minsize"}}minsize="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Minsize_(values ...string) a.Attribute {
+	return Minsize(nil, values...)
+}
+
+func Movablelimits(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Movablelimits"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Movablelimits"}}movablelimits="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Movablelimits"}}movablelimits="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Movablelimits_(values ...string) a.Attribute {
+	return Movablelimits(nil, values...)
+}
+
+func Rowspan(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Rowspan"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Rowspan"}}rowspan="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Rowspan"}}rowspan="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Rowspan_(values ...string) a.Attribute {
+	return Rowspan(nil, values...)
+}
+
+func Rspace(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Rspace"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Rspace"}}rspace="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Rspace"}}rspace="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Rspace_(values ...string) a.Attribute {
+	return Rspace(nil, values...)
+}
+
+func Scriptlevel(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Scriptlevel"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Scriptlevel"}}scriptlevel="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Scriptlevel"}}scriptlevel="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Scriptlevel_(values ...string) a.Attribute {
+	return Scriptlevel(nil, values...)
+}
+
+func Selection(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Selection"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Selection"}}selection="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Selection"}}selection="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Selection_(values ...string) a.Attribute {
+	return Selection(nil, values...)
+}
+
+func Separator(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Separator"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Separator"}}separator="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Separator"}}separator="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Separator_(values ...string) a.Attribute {
+	return Separator(nil, values...)
+}
+
+func Stretchy(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Stretchy"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Stretchy"}}stretchy="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Stretchy"}}stretchy="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Stretchy_(values ...string) a.Attribute {
+	return Stretchy(nil, values...)
+}
+
+func Symmetric(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Symmetric"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Symmetric"}}symmetric="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Symmetric"}}symmetric="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Symmetric_(values ...string) a.Attribute {
+	return Symmetric(nil, values...)
+}
+
+func Voffset(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Voffset"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Voffset"}}voffset="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Voffset"}}voffset="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Voffset_(values ...string) a.Attribute {
+	return Voffset(nil, values...)
+}
+
+func Width(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Width"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Width"}}width="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Width"}}width="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Width_(values ...string) a.Attribute {
+	return Width(nil, values...)
+}
@@ -0,0 +1,265 @@
+// Package mathml provides the elements of MathML Core. The elements follow
+// the rules of foreign content, i.e. elements without children are
+// self-closing:
+//
+//	Math(h.Attr(ma.Display_("block")),
+//	    Mfrac_(Mi_(h.Text("a")), Mn_(h.Text("2"))))
+//
+// Use the attributes of htmlgo/mathml/attributes along with the global
+// ones of htmlgo/attributes.
+package mathml
+
+import (
+	h "github.com/julvo/htmlgo"
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// Begin of generated elements
+
+func Annotation(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("annotation", attrs, children...)
+}
+
+func Annotation_(children ...h.HTML) h.HTML {
+	return Annotation(h.Attr(), children...)
+}
+
+func AnnotationXml(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("annotation-xml", attrs, children...)
+}
+
+func AnnotationXml_(children ...h.HTML) h.HTML {
+	return AnnotationXml(h.Attr(), children...)
+}
+
+func Maction(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("maction", attrs, children...)
+}
+
+func Maction_(children ...h.HTML) h.HTML {
+	return Maction(h.Attr(), children...)
+}
+
+func Math(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("math", attrs, children...)
+}
+
+func Math_(children ...h.HTML) h.HTML {
+	return Math(h.Attr(), children...)
+}
+
+func Merror(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("merror", attrs, children...)
+}
+
+func Merror_(children ...h.HTML) h.HTML {
+	return Merror(h.Attr(), children...)
+}
+
+func Mfrac(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mfrac", attrs, children...)
+}
+
+func Mfrac_(children ...h.HTML) h.HTML {
+	return Mfrac(h.Attr(), children...)
+}
+
+func Mi(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mi", attrs, children...)
+}
+
+func Mi_(children ...h.HTML) h.HTML {
+	return Mi(h.Attr(), children...)
+}
+
+func Mmultiscripts(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mmultiscripts", attrs, children...)
+}
+
+func Mmultiscripts_(children ...h.HTML) h.HTML {
+	return Mmultiscripts(h.Attr(), children...)
+}
+
+func Mn(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mn", attrs, children...)
+}
+
+func Mn_(children ...h.HTML) h.HTML {
+	return Mn(h.Attr(), children...)
+}
+
+func Mo(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mo", attrs, children...)
+}
+
+func Mo_(children ...h.HTML) h.HTML {
+	return Mo(h.Attr(), children...)
+}
+
+func Mover(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mover", attrs, children...)
+}
+
+func Mover_(children ...h.HTML) h.HTML {
+	return Mover(h.Attr(), children...)
+}
+
+func Mpadded(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mpadded", attrs, children...)
+}
+
+func Mpadded_(children ...h.HTML) h.HTML {
+	return Mpadded(h.Attr(), children...)
+}
+
+func Mphantom(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mphantom", attrs, children...)
+}
+
+func Mphantom_(children ...h.HTML) h.HTML {
+	return Mphantom(h.Attr(), children...)
+}
+
+func Mprescripts(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mprescripts", attrs, children...)
+}
+
+func Mprescripts_(children ...h.HTML) h.HTML {
+	return Mprescripts(h.Attr(), children...)
+}
+
+func Mroot(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mroot", attrs, children...)
+}
+
+func Mroot_(children ...h.HTML) h.HTML {
+	return Mroot(h.Attr(), children...)
+}
+
+func Mrow(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mrow", attrs, children...)
+}
+
+func Mrow_(children ...h.HTML) h.HTML {
+	return Mrow(h.Attr(), children...)
+}
+
+func Ms(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("ms", attrs, children...)
+}
+
+func Ms_(children ...h.HTML) h.HTML {
+	return Ms(h.Attr(), children...)
+}
+
+func Mspace(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mspace", attrs, children...)
+}
+
+func Mspace_(children ...h.HTML) h.HTML {
+	return Mspace(h.Attr(), children...)
+}
+
+func Msqrt(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("msqrt", attrs, children...)
+}
+
+func Msqrt_(children ...h.HTML) h.HTML {
+	return Msqrt(h.Attr(), children...)
+}
+
+func Mstyle(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mstyle", attrs, children...)
+}
+
+func Mstyle_(children ...h.HTML) h.HTML {
+	return Mstyle(h.Attr(), children...)
+}
+
+func Msub(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("msub", attrs, children...)
+}
+
+func Msub_(children ...h.HTML) h.HTML {
+	return Msub(h.Attr(), children...)
+}
+
+func Msubsup(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("msubsup", attrs, children...)
+}
+
+func Msubsup_(children ...h.HTML) h.HTML {
+	return Msubsup(h.Attr(), children...)
+}
+
+func Msup(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("msup", attrs, children...)
+}
+
+func Msup_(children ...h.HTML) h.HTML {
+	return Msup(h.Attr(), children...)
+}
+
+func Mtable(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mtable", attrs, children...)
+}
+
+func Mtable_(children ...h.HTML) h.HTML {
+	return Mtable(h.Attr(), children...)
+}
+
+func Mtd(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mtd", attrs, children...)
+}
+
+func Mtd_(children ...h.HTML) h.HTML {
+	return Mtd(h.Attr(), children...)
+}
+
+func Mtext(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mtext", attrs, children...)
+}
+
+func Mtext_(children ...h.HTML) h.HTML {
+	return Mtext(h.Attr(), children...)
+}
+
+func Mtr(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mtr", attrs, children...)
+}
+
+func Mtr_(children ...h.HTML) h.HTML {
+	return Mtr(h.Attr(), children...)
+}
+
+func Munder(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("munder", attrs, children...)
+}
+
+func Munder_(children ...h.HTML) h.HTML {
+	return Munder(h.Attr(), children...)
+}
+
+func Munderover(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("munderover", attrs, children...)
+}
+
+func Munderover_(children ...h.HTML) h.HTML {
+	return Munderover(h.Attr(), children...)
+}
+
+func None(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("none", attrs, children...)
+}
+
+func None_(children ...h.HTML) h.HTML {
+	return None(h.Attr(), children...)
+}
+
+func Semantics(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("semantics", attrs, children...)
+}
+
+func Semantics_(children ...h.HTML) h.HTML {
+	return Semantics(h.Attr(), children...)
+}
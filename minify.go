@@ -0,0 +1,143 @@
+package htmlgo
+
+import (
+	"strings"
+)
+
+// paragraphClosers lists the elements whose start tag closes an open p
+// element, so that the end tag of the p element can be omitted
+var paragraphClosers = map[string]bool{
+	"address":    true,
+	"article":    true,
+	"aside":      true,
+	"blockquote": true,
+	"details":    true,
+	"dialog":     true,
+	"div":        true,
+	"dl":         true,
+	"fieldset":   true,
+	"figcaption": true,
+	"figure":     true,
+	"footer":     true,
+	"form":       true,
+	"h1":         true,
+	"h2":         true,
+	"h3":         true,
+	"h4":         true,
+	"h5":         true,
+	"h6":         true,
+	"header":     true,
+	"hgroup":     true,
+	"hr":         true,
+	"main":       true,
+	"menu":       true,
+	"nav":        true,
+	"ol":         true,
+	"p":          true,
+	"pre":        true,
+	"search":     true,
+	"section":    true,
+	"table":      true,
+	"ul":         true,
+}
+
+// transparentParents lists the elements in which the end tag of a last p
+// child cannot be omitted, as their content model is transparent
+var transparentParents = map[string]bool{
+	"a":        true,
+	"audio":    true,
+	"del":      true,
+	"ins":      true,
+	"map":      true,
+	"noscript": true,
+	"video":    true,
+}
+
+// omitEndTag reports whether the end tag of n can be omitted, given its
+// parent and the node following it, see
+// https://html.spec.whatwg.org/multipage/syntax.html#optional-tags. The
+// end of a fragment is not taken as the end of the parent, as the fragment
+// may be embedded in other content.
+func omitEndTag(n, parent, next *node) bool {
+	if n.foreign {
+		return false
+	}
+	last := next == nil && parent.data != ""
+	followedBy := func(names ...string) bool {
+		if next == nil || next.kind != elementNode || next.foreign {
+			return false
+		}
+		for _, name := range names {
+			if next.name() == name {
+				return true
+			}
+		}
+		return false
+	}
+	followedBySpace := next != nil && next.kind == textNode &&
+		strings.TrimLeft(next.data, " \t\n\r\f") != next.data
+	followedByComment := next != nil && next.kind == commentNode
+
+	switch n.name() {
+	case "html", "body":
+		return !followedByComment
+	case "head", "colgroup", "caption":
+		return !followedBySpace && !followedByComment
+	case "li":
+		return last || followedBy("li")
+	case "dt":
+		return followedBy("dt", "dd")
+	case "dd":
+		return last || followedBy("dd", "dt")
+	case "p":
+		if next == nil {
+			name := parent.name()
+			return last && !transparentParents[name] && !strings.Contains(name, "-")
+		}
+		return next.kind == elementNode && !next.foreign && paragraphClosers[next.name()]
+	case "rt", "rp":
+		return last || followedBy("rt", "rp")
+	case "optgroup":
+		return last || followedBy("optgroup", "hr")
+	case "option":
+		return last || followedBy("option", "optgroup", "hr")
+	case "thead":
+		return followedBy("tbody", "tfoot")
+	case "tbody":
+		return last || followedBy("tbody", "tfoot")
+	case "tfoot":
+		return last
+	case "tr":
+		return last || followedBy("tr")
+	case "td", "th":
+		return last || followedBy("td", "th")
+	}
+	return false
+}
+
+// collapseSpace replaces each run of whitespace in s by a single space
+func collapseSpace(s string) string {
+	out := strings.Builder{}
+	space := false
+	for _, r := range s {
+		if r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '\f' {
+			space = true
+			continue
+		}
+		if space {
+			out.WriteByte(' ')
+			space = false
+		}
+		out.WriteRune(r)
+	}
+	if space {
+		out.WriteByte(' ')
+	}
+	return out.String()
+}
+
+// unquotable reports whether an attribute value can be written without
+// quotes
+func unquotable(value string) bool {
+	return value != "" && !strings.ContainsAny(value, " \t\n\r\f\"'=<>`")
+}
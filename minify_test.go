@@ -0,0 +1,46 @@
+package htmlgo
+
+import (
+	"testing"
+)
+
+func TestMinify(t *testing.T) {
+	tests := []struct {
+		name string
+		in   HTML
+		want HTML
+	}{
+		{"list items", "<ul>\n  <li>a</li>\n  <li>b</li>\n</ul>", "<ul><li>a<li>b</ul>"},
+		{"last list item of fragment", "<li>a</li><li>b</li>", "<li>a<li>b</li>"},
+		{"paragraphs", "<div>\n  <p>a</p>\n  <p>b</p>\n</div>", "<div><p>a<p>b</div>"},
+		{"paragraph before inline", "<div><p>a</p><span>b</span></div>", "<div><p>a</p><span>b</span></div>"},
+		{"paragraph in transparent parent", "<a href=\"/\"><p>a</p></a>", "<a href=/><p>a</p></a>"},
+		{"paragraph in custom element", "<x-card><p>a</p></x-card>", "<x-card><p>a</p></x-card>"},
+		{"options", "<select><option>a</option><option>b</option></select>", "<select><option>a<option>b</select>"},
+		{"option before optgroup", "<select><option>a</option><optgroup label=\"g\"><option>b</option></optgroup></select>", "<select><option>a<optgroup label=g><option>b</select>"},
+		{"description list", "<dl><dt>a</dt><dd>b</dd><dt>c</dt><dd>d</dd></dl>", "<dl><dt>a<dd>b<dt>c<dd>d</dl>"},
+		{"table", "<table><tr><td>a</td><td>b</td></tr><tr><th>c</th></tr></table>", "<table><tr><td>a<td>b<tr><th>c</table>"},
+		{"attributes", "<div id=\"a\" class=\"b c\" title=\"\" hidden></div>", "<div id=a class=\"b c\" title hidden></div>"},
+		{"whitespace", "<div>\n  a   b\n  <span>c</span>\n</div>", "<div> a b <span>c</span></div>"},
+		{"pre", "<pre>\n\na   b\n  c</pre>", "<pre>\n\na   b\n  c</pre>"},
+		{"textarea", "<textarea>a   b</textarea>", "<textarea>a   b</textarea>"},
+		{"comment after body", "<html><body>a</body><!-- b --></html>", "<html><body>a</body><!-- b -->"},
+		{"foreign", "<svg><g><path d=\"M0 0\"></path></g></svg>", "<svg><g><path d=\"M0 0\"></path></g></svg>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (Renderer{Minify: true}).Render(tt.in); got != tt.want {
+				t.Errorf("Render(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinifyXHTML(t *testing.T) {
+	in := HTML("<ul><li class=\"a\">a</li><li>b</li></ul>")
+	want := HTML("<ul><li class=\"a\">a</li><li>b</li></ul>")
+	if got := (Renderer{Minify: true, XHTML: true}).Render(in); got != want {
+		t.Errorf("Render(%q) = %q, want %q", in, got, want)
+	}
+}
@@ -0,0 +1,361 @@
+// Package attributes provides the presentational attributes which the HTML
+// Living Standard marks as obsolete. Prefer CSS for new code.
+package attributes
+
+import (
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// Begin of generated attributes
+
+func Align(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Align"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Align"}}align="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Align"}}align="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Align_(values ...string) a.Attribute {
+	return Align(nil, values...)
+}
+
+func Alink(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Alink"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Alink"}}alink="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Alink"}}alink="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Alink_(values ...string) a.Attribute {
+	return Alink(nil, values...)
+}
+
+func Background(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Background"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Background"}}background="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Background"}}background="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Background_(values ...string) a.Attribute {
+	return Background(nil, values...)
+}
+
+func Bgcolor(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Bgcolor"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Bgcolor"}}bgcolor="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Bgcolor"}}bgcolor="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Bgcolor_(values ...string) a.Attribute {
+	return Bgcolor(nil, values...)
+}
+
+func Border(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Border"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Border"}}border="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Border"}}border="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Border_(values ...string) a.Attribute {
+	return Border(nil, values...)
+}
+
+func Cellpadding(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Cellpadding"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Cellpadding"}}cellpadding="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Cellpadding"}}cellpadding="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Cellpadding_(values ...string) a.Attribute {
+	return Cellpadding(nil, values...)
+}
+
+func Cellspacing(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Cellspacing"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Cellspacing"}}cellspacing="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Cellspacing"}}cellspacing="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Cellspacing_(values ...string) a.Attribute {
+	return Cellspacing(nil, values...)
+}
+
+func Char(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Char"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Char"}}char="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Char"}}char="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Char_(values ...string) a.Attribute {
+	return Char(nil, values...)
+}
+
+func Charoff(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Charoff"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Charoff"}}charoff="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Charoff"}}charoff="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Charoff_(values ...string) a.Attribute {
+	return Charoff(nil, values...)
+}
+
+func Clear(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Clear"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Clear"}}clear="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Clear"}}clear="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Clear_(values ...string) a.Attribute {
+	return Clear(nil, values...)
+}
+
+func Compact(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Compact"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Compact"}}compact="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Compact"}}compact="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Compact_(values ...string) a.Attribute {
+	return Compact(nil, values...)
+}
+
+func Frame(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Frame"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Frame"}}frame="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Frame"}}frame="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Frame_(values ...string) a.Attribute {
+	return Frame(nil, values...)
+}
+
+func Frameborder(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Frameborder"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Frameborder"}}frameborder="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Frameborder"}}frameborder="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Frameborder_(values ...string) a.Attribute {
+	return Frameborder(nil, values...)
+}
+
+func Hspace(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Hspace"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Hspace"}}hspace="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Hspace"}}hspace="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Hspace_(values ...string) a.Attribute {
+	return Hspace(nil, values...)
+}
+
+func Link(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Link"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Link"}}link="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Link"}}link="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Link_(values ...string) a.Attribute {
+	return Link(nil, values...)
+}
+
+func Marginheight(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Marginheight"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Marginheight"}}marginheight="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Marginheight"}}marginheight="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Marginheight_(values ...string) a.Attribute {
+	return Marginheight(nil, values...)
+}
+
+func Marginwidth(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Marginwidth"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Marginwidth"}}marginwidth="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Marginwidth"}}marginwidth="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Marginwidth_(values ...string) a.Attribute {
+	return Marginwidth(nil, values...)
+}
+
+func Noshade(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Noshade"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Noshade"}}noshade="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Noshade"}}noshade="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Noshade_(values ...string) a.Attribute {
+	return Noshade(nil, values...)
+}
+
+func Nowrap(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Nowrap"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Nowrap"}}nowrap="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Nowrap"}}nowrap="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Nowrap_(values ...string) a.Attribute {
+	return Nowrap(nil, values...)
+}
+
+func Rules(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Rules"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Rules"}}rules="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Rules"}}rules="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Rules_(values ...string) a.Attribute {
+	return Rules(nil, values...)
+}
+
+func Scrolling(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Scrolling"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Scrolling"}}scrolling="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Scrolling"}}scrolling="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Scrolling_(values ...string) a.Attribute {
+	return Scrolling(nil, values...)
+}
+
+func Text(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Text"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Text"}}text="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Text"}}text="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Text_(values ...string) a.Attribute {
+	return Text(nil, values...)
+}
+
+func Valign(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Valign"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Valign"}}valign="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Valign"}}valign="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Valign_(values ...string) a.Attribute {
+	return Valign(nil, values...)
+}
+
+func Vlink(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Vlink"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Vlink"}}vlink="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Vlink"}}vlink="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Vlink_(values ...string) a.Attribute {
+	return Vlink(nil, values...)
+}
+
+func Vspace(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Vspace"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Vspace"}}vspace="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Vspace"}}vspace="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Vspace_(values ...string) a.Attribute {
+	return Vspace(nil, values...)
+}
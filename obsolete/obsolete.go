@@ -0,0 +1,239 @@
+// Package obsolete provides the elements which the HTML Living Standard
+// marks as obsolete and non-conforming. They are kept apart from htmlgo so
+// new code does not use them by accident.
+package obsolete
+
+import (
+	h "github.com/julvo/htmlgo"
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// Begin of generated elements
+
+func Acronym(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("acronym", attrs, children...)
+}
+
+func Acronym_(children ...h.HTML) h.HTML {
+	return Acronym(h.Attr(), children...)
+}
+
+func Applet(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("applet", attrs, children...)
+}
+
+func Applet_(children ...h.HTML) h.HTML {
+	return Applet(h.Attr(), children...)
+}
+
+func Big(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("big", attrs, children...)
+}
+
+func Big_(children ...h.HTML) h.HTML {
+	return Big(h.Attr(), children...)
+}
+
+func Blink(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("blink", attrs, children...)
+}
+
+func Blink_(children ...h.HTML) h.HTML {
+	return Blink(h.Attr(), children...)
+}
+
+func Center(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("center", attrs, children...)
+}
+
+func Center_(children ...h.HTML) h.HTML {
+	return Center(h.Attr(), children...)
+}
+
+func Dir(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("dir", attrs, children...)
+}
+
+func Dir_(children ...h.HTML) h.HTML {
+	return Dir(h.Attr(), children...)
+}
+
+func Font(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("font", attrs, children...)
+}
+
+func Font_(children ...h.HTML) h.HTML {
+	return Font(h.Attr(), children...)
+}
+
+func Frameset(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("frameset", attrs, children...)
+}
+
+func Frameset_(children ...h.HTML) h.HTML {
+	return Frameset(h.Attr(), children...)
+}
+
+func Listing(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("listing", attrs, children...)
+}
+
+func Listing_(children ...h.HTML) h.HTML {
+	return Listing(h.Attr(), children...)
+}
+
+func Marquee(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("marquee", attrs, children...)
+}
+
+func Marquee_(children ...h.HTML) h.HTML {
+	return Marquee(h.Attr(), children...)
+}
+
+func Menuitem(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("menuitem", attrs, children...)
+}
+
+func Menuitem_(children ...h.HTML) h.HTML {
+	return Menuitem(h.Attr(), children...)
+}
+
+func Multicol(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("multicol", attrs, children...)
+}
+
+func Multicol_(children ...h.HTML) h.HTML {
+	return Multicol(h.Attr(), children...)
+}
+
+func Nextid(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("nextid", attrs, children...)
+}
+
+func Nextid_(children ...h.HTML) h.HTML {
+	return Nextid(h.Attr(), children...)
+}
+
+func Nobr(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("nobr", attrs, children...)
+}
+
+func Nobr_(children ...h.HTML) h.HTML {
+	return Nobr(h.Attr(), children...)
+}
+
+func Noembed(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("noembed", attrs, children...)
+}
+
+func Noembed_(children ...h.HTML) h.HTML {
+	return Noembed(h.Attr(), children...)
+}
+
+func Noframes(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("noframes", attrs, children...)
+}
+
+func Noframes_(children ...h.HTML) h.HTML {
+	return Noframes(h.Attr(), children...)
+}
+
+func Plaintext(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("plaintext", attrs, children...)
+}
+
+func Plaintext_(children ...h.HTML) h.HTML {
+	return Plaintext(h.Attr(), children...)
+}
+
+func Rb(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("rb", attrs, children...)
+}
+
+func Rb_(children ...h.HTML) h.HTML {
+	return Rb(h.Attr(), children...)
+}
+
+func Rtc(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("rtc", attrs, children...)
+}
+
+func Rtc_(children ...h.HTML) h.HTML {
+	return Rtc(h.Attr(), children...)
+}
+
+func Spacer(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("spacer", attrs, children...)
+}
+
+func Spacer_(children ...h.HTML) h.HTML {
+	return Spacer(h.Attr(), children...)
+}
+
+func Strike(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("strike", attrs, children...)
+}
+
+func Strike_(children ...h.HTML) h.HTML {
+	return Strike(h.Attr(), children...)
+}
+
+func Tt(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("tt", attrs, children...)
+}
+
+func Tt_(children ...h.HTML) h.HTML {
+	return Tt(h.Attr(), children...)
+}
+
+func Xmp(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.Element("xmp", attrs, children...)
+}
+
+func Xmp_(children ...h.HTML) h.HTML {
+	return Xmp(h.Attr(), children...)
+}
+
+// Begin of generated void elements
+
+func Basefont(attrs []a.Attribute) h.HTML {
+	return h.VoidElement("basefont", attrs)
+}
+func Basefont_() h.HTML {
+	return Basefont(h.Attr())
+}
+
+func Bgsound(attrs []a.Attribute) h.HTML {
+	return h.VoidElement("bgsound", attrs)
+}
+func Bgsound_() h.HTML {
+	return Bgsound(h.Attr())
+}
+
+func Frame(attrs []a.Attribute) h.HTML {
+	return h.VoidElement("frame", attrs)
+}
+func Frame_() h.HTML {
+	return Frame(h.Attr())
+}
+
+func Isindex(attrs []a.Attribute) h.HTML {
+	return h.VoidElement("isindex", attrs)
+}
+func Isindex_() h.HTML {
+	return Isindex(h.Attr())
+}
+
+func Keygen(attrs []a.Attribute) h.HTML {
+	return h.VoidElement("keygen", attrs)
+}
+func Keygen_() h.HTML {
+	return Keygen(h.Attr())
+}
+
+func Param(attrs []a.Attribute) h.HTML {
+	return h.VoidElement("param", attrs)
+}
+func Param_() h.HTML {
+	return Param(h.Attr())
+}
@@ -0,0 +1,348 @@
+package htmlgo
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// Renderer serialises HTML produced by this package, reformatting it for
+// the consumer, e.g. browsers, email clients or test snapshots. For pages
+// built from elements of this package, only whitespace which has no effect
+// on the rendering of the page is changed, i.e. line breaks and indentation
+// around block elements. The contents of inline and preformatted elements
+// are kept as is. Raw HTML, e.g. passed to Text_, is parsed as well-formed
+// and normalised: unclosed elements are closed, stray end tags dropped,
+// attributes quoted anew and text reindented. WriteTo of this package
+// writes pages as is, unless DefaultRenderer is set.
+type Renderer struct {
+	// Indent is written once per level of nesting at the start of a line
+	Indent string
+	// Compact omits the line breaks and indentation between elements
+	Compact bool
+	// CRLF ends lines with \r\n instead of \n
+	CRLF bool
+	// SingleQuotes quotes attribute values with ' instead of "
+	SingleQuotes bool
+	// SelfCloseVoid writes void elements as <br/> instead of <br>
+	SelfCloseVoid bool
+	// SortAttributes writes attributes in order of their names instead of
+	// the order they were passed in
+	SortAttributes bool
+	// Minify implies Compact, omits optional end tags, e.g. </li> and </p>,
+	// writes attribute values without quotes where possible and collapses
+	// whitespace in text, except within preformatted elements
+	Minify bool
+	// XHTML serialises pages as well-formed XML, e.g. for EPUB, which HTML
+	// parsers read into the same tree, except for a line break starting
+	// preformatted text. Void elements are written as <br />, boolean
+	// attributes get their name as value, e.g. disabled="disabled", the line
+	// break which Element doubles at the start of preformatted text is
+	// written once, text is escaped for XML, the html, svg and math elements
+	// declare their namespaces, the contents of scripts and styles are placed
+	// into CDATA sections, and the doctype of HTML5 is replaced by an XML
+	// declaration. XHTML keeps end tags and quotes when minifying.
+	XHTML bool
+}
+
+// DefaultRenderer is used by WriteTo, so that all pages written are
+// formatted for the same consumer. It is nil by default, which writes pages
+// as built, i.e. indented by two spaces. Set it during initialisation, as it
+// is read without synchronisation:
+//
+//	htmlgo.DefaultRenderer = &htmlgo.Renderer{Minify: true}
+var DefaultRenderer *Renderer
+
+// Render reformats h
+func (r Renderer) Render(h HTML) HTML {
+	w := &writer{r: r}
+	if r.Minify {
+		w.r.Compact = true
+	}
+	if w.r.Compact {
+		w.r.Indent = ""
+	}
+	w.children(parse(string(h)), 0, false)
+	s := w.String()
+	if r.CRLF {
+		s = strings.Replace(s, "\r\n", "\n", -1)
+		s = strings.Replace(s, "\n", "\r\n", -1)
+	}
+	return HTML(s)
+}
+
+// WriteTo writes h to w after reformatting it
+func (r Renderer) WriteTo(w io.Writer, h HTML) {
+	io.WriteString(w, string(r.Render(h)))
+}
+
+// writer serialises the tree parsed from HTML produced by this package. Its
+// line breaks are those of Element, so that a Renderer indenting by two
+// spaces reproduces pages built from elements.
+type writer struct {
+	strings.Builder
+	r Renderer
+}
+
+// newline starts a line indented for the given depth
+func (w *writer) newline(depth int) {
+	if w.r.Compact {
+		return
+	}
+	w.WriteString("\n" + strings.Repeat(w.r.Indent, depth))
+}
+
+// reindent replaces the indentation of this package in multi-line text at
+// the given depth by the indentation of the renderer
+func (w *writer) reindent(s string, depth int) string {
+	if w.r.Indent == "  " {
+		return s
+	}
+	return strings.Replace(s,
+		"\n"+strings.Repeat("  ", depth),
+		"\n"+strings.Repeat(w.r.Indent, depth), -1)
+}
+
+// isBlock reports whether n starts on a new line
+func isBlock(n *node) bool {
+	switch n.kind {
+	case elementNode:
+		return n.foreign || !inlineTags[n.name()]
+	case commentNode, cdataNode:
+		return true
+	}
+	return false
+}
+
+// children writes the children of n at the given depth. Unless verbatim,
+// line breaks and indentation preceding block elements or the end of n are
+// replaced by the formatting of the renderer.
+func (w *writer) children(n *node, depth int, verbatim bool) {
+	if verbatim {
+		for _, c := range n.children {
+			w.node(c, n, nil, depth, true)
+		}
+		return
+	}
+	inline := n.kind == elementNode && !n.foreign && inlineTags[n.name()]
+	children := []*node{}
+	for i, c := range n.children {
+		if c.kind == textNode {
+			text := c.data
+			last := i == len(n.children)-1
+			if (last && !inline) || (!last && isBlock(n.children[i+1])) {
+				text = trimFormatting(text)
+			}
+			if w.r.Minify {
+				text = collapseSpace(text)
+			}
+			if text == "" {
+				continue
+			}
+			c = &node{kind: textNode, data: w.reindent(text, depth)}
+		}
+		children = append(children, c)
+	}
+	for i, c := range children {
+		var next *node
+		if i+1 < len(children) {
+			next = children[i+1]
+		}
+		if isBlock(c) {
+			w.newline(depth)
+		}
+		w.node(c, n, next, depth, false)
+	}
+}
+
+// trimFormatting removes trailing whitespace which contains a line break
+func trimFormatting(s string) string {
+	trimmed := strings.TrimRight(s, " \t\r\n\f")
+	if !strings.Contains(s[len(trimmed):], "\n") {
+		return s
+	}
+	return trimmed
+}
+
+// node writes n, whose parent and following sibling are used to decide
+// whether its end tag can be omitted
+func (w *writer) node(n, parent, next *node, depth int, verbatim bool) {
+	switch n.kind {
+	case textNode:
+		if w.r.XHTML {
+			w.WriteString(xmlText(n.data))
+		} else {
+			w.WriteString(n.data)
+		}
+		return
+	case doctypeNode:
+		if w.r.XHTML && !strings.HasPrefix(n.data, "<?xml") {
+			w.WriteString(xmlDeclaration)
+			if strings.EqualFold(n.data, string(DoctypeHtml5)) {
+				return
+			}
+			w.newline(depth)
+		}
+		w.WriteString(n.data)
+		return
+	case cdataNode:
+		// the text of the section is kept as is, without indentation
+		if verbatim {
+			w.WriteString(n.data)
+		} else {
+			w.WriteString(strings.Replace(n.data, "\n"+strings.Repeat("  ", depth), "\n", -1))
+		}
+		return
+	case commentNode:
+		if verbatim {
+			w.WriteString(n.data)
+		} else {
+			w.WriteString(w.reindent(n.data, depth))
+		}
+		return
+	}
+
+	attrs := n.attrs
+	if w.r.XHTML && !n.foreign {
+		attrs = xmlBooleanAttributes(attrs)
+	}
+	if ns := namespace(n, parent); w.r.XHTML && ns != "" {
+		if !hasAttribute(n, "xmlns") {
+			attrs = append([]a.Rendered{{Name: "xmlns", Value: ns, HasValue: true}}, attrs...)
+		}
+		if ns == svgNamespace && !hasAttribute(n, "xmlns:xlink") && usesXlink(n) {
+			attrs = append(attrs, a.Rendered{Name: "xmlns:xlink", Value: xlinkNamespace, HasValue: true})
+		}
+	}
+
+	w.WriteString("<" + n.data)
+	unquoted := w.attributes(attrs)
+	switch {
+	case !n.foreign && voidTags[n.name()] && w.r.XHTML:
+		w.WriteString(" />")
+		return
+	case n.selfClosing || (!n.foreign && voidTags[n.name()] && w.r.SelfCloseVoid):
+		if unquoted {
+			// the slash would be part of the unquoted value
+			w.WriteString(" ")
+		}
+		w.WriteString("/>")
+		return
+	case !n.foreign && voidTags[n.name()]:
+		w.WriteString(">")
+		return
+	}
+	w.WriteString(">")
+
+	name := n.name()
+	switch {
+	case verbatim || (!n.foreign && (preformattedTags[name] || escapableRawTextTags[name])):
+		if w.r.XHTML && !verbatim && preformattedTags[name] {
+			n = trimLeadingNewline(n)
+		}
+		w.children(n, depth, true)
+	case !n.foreign && rawTextTags[name]:
+		w.rawText(n, depth)
+	case !n.foreign && inlineTags[name]:
+		w.children(n, depth, false)
+	case n.foreign && containsCDATA(n):
+		// CDATA sections are text, so the formatting around them would be
+		// part of the text
+		for _, c := range n.children {
+			if c.kind != textNode || strings.TrimSpace(c.data) != "" {
+				w.node(c, n, nil, depth+1, false)
+			}
+		}
+	default:
+		start := w.Len()
+		w.children(n, depth+1, false)
+		if strings.Contains(w.String()[start:], "\n") {
+			w.newline(depth)
+		}
+	}
+	if w.r.Minify && !w.r.XHTML && !verbatim && omitEndTag(n, parent, next) {
+		return
+	}
+	w.WriteString("</" + n.data + ">")
+}
+
+// rawText writes the contents of a script or style element, which are
+// indented by one level, but whose line breaks are kept
+func (w *writer) rawText(n *node, depth int) {
+	if len(n.children) == 0 {
+		return
+	}
+	text := strings.TrimRight(n.children[0].data, " \t")
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "\n"), "\n")
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	indentation := strings.Repeat("  ", depth+1)
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, indentation)
+	}
+	if w.r.XHTML && strings.ContainsAny(text, "<&") {
+		// the markers of the section are commented out for HTML parsers
+		start, end := "//<![CDATA[", "//]]>"
+		if n.name() == "style" {
+			start, end = "/*<![CDATA[*/", "/*]]>*/"
+		}
+		for i, line := range lines {
+			lines[i] = strings.Replace(line, "]]>", "]]]]><![CDATA[>", -1)
+		}
+		lines = append(append([]string{start}, lines...), end)
+	}
+	for i, line := range lines {
+		if i > 0 && w.r.Compact {
+			w.WriteString("\n")
+		}
+		w.newline(depth + 1)
+		w.WriteString(line)
+	}
+	w.newline(depth)
+}
+
+// containsCDATA reports whether n has a CDATA section as child
+func containsCDATA(n *node) bool {
+	for _, c := range n.children {
+		if c.kind == cdataNode {
+			return true
+		}
+	}
+	return false
+}
+
+// attributes writes attrs and reports whether the last value is unquoted
+func (w *writer) attributes(attrs []a.Rendered) bool {
+	if w.r.SortAttributes {
+		attrs = append([]a.Rendered{}, attrs...)
+		sort.SliceStable(attrs, func(i, j int) bool {
+			return attrs[i].Name < attrs[j].Name
+		})
+	}
+	quote, escaped := `"`, "&#34;"
+	if w.r.SingleQuotes {
+		quote, escaped = "'", "&#39;"
+	}
+	unquoted := false
+	for _, attr := range attrs {
+		w.WriteString(" " + attr.Name)
+		unquoted = false
+		switch {
+		case w.r.XHTML && !attr.HasValue:
+			w.WriteString("=" + quote + attr.Name + quote)
+		case w.r.XHTML:
+			w.WriteString("=" + quote + strings.Replace(xmlAttribute(attr.Value), quote, escaped, -1) + quote)
+		case !attr.HasValue || (w.r.Minify && attr.Value == ""):
+		case w.r.Minify && unquotable(attr.Value):
+			w.WriteString("=" + attr.Value)
+			unquoted = true
+		default:
+			w.WriteString("=" + quote + strings.Replace(attr.Value, quote, escaped, -1) + quote)
+		}
+	}
+	return unquoted
+}
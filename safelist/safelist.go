@@ -0,0 +1,151 @@
+// Package safelist collects the class tokens used with the Class family of
+// htmlgo/attributes, i.e. Class, Class_, Classes and ClassIf, to write a
+// safelist for tools purging unused CSS, such as PurgeCSS or Tailwind.
+//
+// Classes are collected either statically, by analysing Go sources with
+// ScanFiles, or by recording the class attributes of rendered pages with
+// Record. Static analysis only sees literal class names. Classes built from
+// data are reported as Dynamic, so that they can be added to the safelist
+// by hand or covered by recording a render.
+package safelist
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/julvo/htmlgo"
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// Dynamic is a class expression which cannot be resolved statically
+type Dynamic struct {
+	// Pos is the position of the expression as file:line:column
+	Pos string `json:"pos"`
+	// Func is the name of the attribute function, e.g. Classes
+	Func string `json:"func"`
+	// Expr is the source of the expression
+	Expr string `json:"expr"`
+}
+
+func (d Dynamic) String() string {
+	return fmt.Sprintf("%s: %s(%s)", d.Pos, d.Func, d.Expr)
+}
+
+// Report holds the collected class tokens and the dynamic class expressions
+type Report struct {
+	classes map[string]struct{}
+	dynamic []Dynamic
+}
+
+// Add adds class tokens, splitting each argument at whitespace
+func (r *Report) Add(classes ...string) {
+	if r.classes == nil {
+		r.classes = map[string]struct{}{}
+	}
+	for _, c := range classes {
+		for _, token := range strings.Fields(c) {
+			r.classes[token] = struct{}{}
+		}
+	}
+}
+
+// AddDynamic records a class expression which cannot be resolved
+func (r *Report) AddDynamic(d Dynamic) {
+	r.dynamic = append(r.dynamic, d)
+}
+
+// Merge adds the classes and dynamic expressions of another report
+func (r *Report) Merge(other Report) {
+	for c := range other.classes {
+		r.Add(c)
+	}
+	r.dynamic = append(r.dynamic, other.dynamic...)
+}
+
+// Classes returns the collected class tokens in sorted order
+func (r Report) Classes() []string {
+	classes := make([]string, 0, len(r.classes))
+	for c := range r.classes {
+		classes = append(classes, c)
+	}
+	sort.Strings(classes)
+	return classes
+}
+
+// Dynamic returns the class expressions which could not be resolved
+func (r Report) Dynamic() []Dynamic {
+	return append([]Dynamic(nil), r.dynamic...)
+}
+
+// WriteText writes the classes one per line, which Tailwind and PurgeCSS can
+// read as content file
+func (r Report) WriteText(w io.Writer) error {
+	for _, c := range r.Classes() {
+		if _, err := io.WriteString(w, c+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the classes as the safelist array of a JSON object,
+// along with the dynamic class expressions
+func (r Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Safelist []string  `json:"safelist"`
+		Dynamic  []Dynamic `json:"dynamic"`
+	}{r.Classes(), append([]Dynamic{}, r.dynamic...)})
+}
+
+// Record collects the class tokens of all class attributes of rendered HTML.
+// Text and comments which look like class attributes are skipped.
+func Record(pages ...htmlgo.HTML) Report {
+	r := Report{}
+	for _, page := range pages {
+		htmlgo.WalkElements(page, func(tag string, attrs []a.Rendered) {
+			for _, attr := range attrs {
+				if strings.EqualFold(attr.Name, "class") {
+					r.Add(html.UnescapeString(attr.Value))
+				}
+			}
+		})
+	}
+	return r
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[string]func() htmlgo.HTML{}
+)
+
+// Register adds a page to be rendered by RecordRegistered. Pages should be
+// registered with data exercising all classes, e.g. in an init function of
+// a test or build tool.
+func Register(name string, render func() htmlgo.HTML) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = render
+}
+
+// RecordRegistered renders all registered pages and records their classes
+func RecordRegistered() Report {
+	registryMu.Lock()
+	renders := make([]func() htmlgo.HTML, 0, len(registry))
+	for _, render := range registry {
+		renders = append(renders, render)
+	}
+	registryMu.Unlock()
+
+	r := Report{}
+	for _, render := range renders {
+		r.Merge(Record(render()))
+	}
+	return r
+}
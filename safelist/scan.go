@@ -0,0 +1,257 @@
+package safelist
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// AttributesPath is the import path of the package defining the Class family
+const AttributesPath = "github.com/julvo/htmlgo/attributes"
+
+// ScanFiles statically collects the class tokens of Go source files. Paths
+// may be files or directories, which are walked recursively, skipping
+// vendor, testdata and hidden directories as well as _test.go files.
+func ScanFiles(paths ...string) (Report, error) {
+	r := Report{}
+	for _, path := range paths {
+		err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				name := info.Name()
+				if p != path && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(p, ".go") || strings.HasSuffix(p, "_test.go") {
+				return nil
+			}
+			src, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			scanned, err := ScanSource(p, src)
+			if err != nil {
+				return err
+			}
+			r.Merge(scanned)
+			return nil
+		})
+		if err != nil {
+			return r, err
+		}
+	}
+	return r, nil
+}
+
+// ScanSource statically collects the class tokens of a Go source file
+func ScanSource(filename string, src []byte) (Report, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return Report{}, err
+	}
+	s := scanner{fset: fset}
+
+	for _, imp := range file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || path != AttributesPath {
+			continue
+		}
+		switch {
+		case imp.Name == nil:
+			s.pkg = "attributes"
+		case imp.Name.Name == ".":
+			s.dot = true
+		case imp.Name.Name != "_":
+			s.pkg = imp.Name.Name
+		}
+	}
+	if s.pkg == "" && !s.dot {
+		return s.report, nil
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			s.call(call)
+		}
+		return true
+	})
+	return s.report, nil
+}
+
+type scanner struct {
+	fset   *token.FileSet
+	pkg    string
+	dot    bool
+	report Report
+}
+
+// funcName returns the name of the called function if it is one of the
+// attributes package
+func (s *scanner) funcName(call *ast.CallExpr) string {
+	switch fun := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		if x, ok := fun.X.(*ast.Ident); ok && s.pkg != "" && x.Name == s.pkg {
+			return fun.Sel.Name
+		}
+	case *ast.Ident:
+		if s.dot {
+			return fun.Name
+		}
+	}
+	return ""
+}
+
+func (s *scanner) call(call *ast.CallExpr) {
+	name := s.funcName(call)
+	switch name {
+	case "Class_":
+		for _, arg := range call.Args {
+			s.template(name, arg)
+		}
+	case "Class":
+		if len(call.Args) == 0 {
+			return
+		}
+		if len(call.Args) == 1 {
+			s.literal(name, call.Args[0])
+			return
+		}
+		for _, arg := range call.Args[1:] {
+			s.template(name, arg)
+		}
+	case "ClassIf":
+		if len(call.Args) == 0 {
+			return
+		}
+		for _, arg := range call.Args[1:] {
+			s.literal(name, arg)
+		}
+	case "Classes":
+		for _, arg := range call.Args {
+			s.item(name, arg)
+		}
+	}
+}
+
+// item collects the tokens of an argument of Classes
+func (s *scanner) item(name string, arg ast.Expr) {
+	switch v := arg.(type) {
+	case *ast.CallExpr:
+		// ClassIf is collected when visiting the call itself
+		if s.funcName(v) == "ClassIf" {
+			return
+		}
+	case *ast.CompositeLit:
+		for _, elt := range v.Elts {
+			// for maps, all keys are collected regardless of their value
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				elt = kv.Key
+			}
+			s.literal(name, elt)
+		}
+		return
+	}
+	s.literal(name, arg)
+}
+
+// literal collects the tokens of a constant string expression, reporting
+// any other expression as dynamic
+func (s *scanner) literal(name string, arg ast.Expr) {
+	if v, ok := constString(arg); ok {
+		s.report.Add(v)
+		return
+	}
+	if id, ok := arg.(*ast.Ident); ok && id.Name == "nil" {
+		return
+	}
+	s.dynamic(name, arg)
+}
+
+// template collects the static tokens of an attribute template. Tokens
+// adjacent to template actions are only complete at render time, so the
+// template is reported as dynamic.
+func (s *scanner) template(name string, arg ast.Expr) {
+	v, ok := constString(arg)
+	if !ok {
+		s.dynamic(name, arg)
+		return
+	}
+	static, actions := stripActions(v)
+	for _, t := range strings.Fields(static) {
+		if !strings.ContainsRune(t, actionMark) {
+			s.report.Add(t)
+		}
+	}
+	if actions {
+		s.dynamic(name, arg)
+	}
+}
+
+func (s *scanner) dynamic(name string, arg ast.Expr) {
+	buf := new(bytes.Buffer)
+	printer.Fprint(buf, s.fset, arg)
+	pos := s.fset.Position(arg.Pos())
+	s.report.AddDynamic(Dynamic{
+		Pos:  fmt.Sprintf("%s:%d:%d", pos.Filename, pos.Line, pos.Column),
+		Func: name,
+		Expr: buf.String(),
+	})
+}
+
+const actionMark = '\x00'
+
+// stripActions replaces template actions by actionMark
+func stripActions(templ string) (string, bool) {
+	out := ""
+	found := false
+	for {
+		start := strings.Index(templ, "{{")
+		if start < 0 {
+			return out + templ, found
+		}
+		end := strings.Index(templ[start:], "}}")
+		if end < 0 {
+			return out + templ[:start] + string(actionMark), true
+		}
+		out += templ[:start] + string(actionMark)
+		templ = templ[start+end+2:]
+		found = true
+	}
+}
+
+// constString evaluates string literals and their concatenation
+func constString(e ast.Expr) (string, bool) {
+	switch v := e.(type) {
+	case *ast.BasicLit:
+		if v.Kind != token.STRING {
+			return "", false
+		}
+		s, err := strconv.Unquote(v.Value)
+		return s, err == nil
+	case *ast.ParenExpr:
+		return constString(v.X)
+	case *ast.BinaryExpr:
+		if v.Op != token.ADD {
+			return "", false
+		}
+		x, ok := constString(v.X)
+		if !ok {
+			return "", false
+		}
+		y, ok := constString(v.Y)
+		return x + y, ok
+	}
+	return "", false
+}
@@ -0,0 +1,80 @@
+package htmlgo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
+	"github.com/julvo/htmlgo/css"
+)
+
+// Scope is the stylesheet of a component, whose rules only apply within the
+// component's root element. The root element is marked by a class built from
+// the component's name and a hash of its stylesheet, so that two components
+// defining .title do not collide:
+//
+//	var card = NewScope("card",
+//	    css.Rule(".title", css.FontWeight(css.Bold)),
+//	    css.Rule("&:hover", css.Opacity(css.Number(0.8))))
+//
+//	func Card(title string) HTML {
+//	    return card.Element("div", Attr(), H2(Attr(a.Class_("title")), Text(title)))
+//	}
+//
+// Selectors of the stylesheet are restricted to descendants of the root
+// element, while & refers to the root element itself, see
+// css.Selector.Scope.
+type Scope struct {
+	class string
+	sheet css.Sheet
+}
+
+// NewScope creates the scope of a component from its stylesheet
+func NewScope(name string, items ...css.Item) *Scope {
+	sheet := css.NewSheet(items...)
+	hash := sha256.Sum256([]byte(name + "\n" + sheet.String()))
+	class := scopeName(name) + "-" + hex.EncodeToString(hash[:])[:8]
+	return &Scope{
+		class: class,
+		sheet: sheet.Scope(css.Selector("." + class)),
+	}
+}
+
+// scopeName reduces a name to the characters allowed in a class name
+func scopeName(name string) string {
+	s := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		}
+		return '-'
+	}, name)
+	if s == "" || s[0] >= '0' && s[0] <= '9' || s[0] == '-' {
+		s = "s" + s
+	}
+	return s
+}
+
+// Class returns the class marking the root element of the component
+func (s *Scope) Class() string {
+	return s.class
+}
+
+// Sheet returns the scoped stylesheet
+func (s *Scope) Sheet() css.Sheet {
+	return s.sheet
+}
+
+// Style returns the style element of the scoped stylesheet
+func (s *Scope) Style() HTML {
+	return Style_(Stylesheet(s.sheet.CSS()))
+}
+
+// Element builds the root element of the component, adding the scope class
+// to its attributes. The scoped stylesheet is included using HeadOnce, so
+// that it is rendered once per page.
+func (s *Scope) Element(tag string, attrs []a.Attribute, children ...HTML) HTML {
+	attrs = append([]a.Attribute{a.Class_(s.class)}, attrs...)
+	return HeadOnce(s.class, s.Style()) + Element(tag, attrs, children...)
+}
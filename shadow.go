@@ -0,0 +1,67 @@
+package htmlgo
+
+import (
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// ShadowMode is the mode of a shadow root, determining whether scripts of
+// the page can access it via element.shadowRoot
+type ShadowMode string
+
+const (
+	ShadowOpen   ShadowMode = "open"
+	ShadowClosed ShadowMode = "closed"
+)
+
+// ShadowRootOptions configure a declarative shadow root
+type ShadowRootOptions struct {
+	// Mode defaults to ShadowOpen
+	Mode ShadowMode
+	// DelegatesFocus focuses the first focusable element of the shadow root
+	// when the host is focused
+	DelegatesFocus bool
+	// Clonable includes the shadow root when the host is cloned
+	Clonable bool
+	// Serializable includes the shadow root in getHTML
+	Serializable bool
+	// Style is placed into a style element at the start of the shadow
+	// root. Styles of a shadow root only apply within it.
+	Style CSS
+}
+
+// ShadowRoot renders a declarative shadow root, which the parser attaches to
+// the parent element. It must be the first child of the host element, which
+// can be any element built with Element, followed by the light DOM children.
+// Light DOM children are projected into the slots of the shadow root, using
+// the slot attribute for named slots:
+//
+//	Div(Attr(),
+//	    ShadowRoot(ShadowRootOptions{Style: Stylesheet_("b { color: red; }")},
+//	        B_(Slot(Attr(a.Name_("title")))),
+//	        Slot_()),
+//	    Span(Attr(a.Slot_("title")), Text("Title")),
+//	    Text("Content"))
+func ShadowRoot(opts ShadowRootOptions, children ...HTML) HTML {
+	mode := opts.Mode
+	if mode != ShadowClosed {
+		mode = ShadowOpen
+	}
+	attrs := Attr(a.Shadowrootmode(string(mode)))
+	if opts.DelegatesFocus {
+		attrs = append(attrs, a.Shadowrootdelegatesfocus_())
+	}
+	if opts.Clonable {
+		attrs = append(attrs, a.Shadowrootclonable_())
+	}
+	if opts.Serializable {
+		attrs = append(attrs, a.Shadowrootserializable_())
+	}
+	if opts.Style.templ != "" {
+		children = append([]HTML{Style_(opts.Style)}, children...)
+	}
+	return Template(attrs, children...)
+}
+
+func ShadowRoot_(children ...HTML) HTML {
+	return ShadowRoot(ShadowRootOptions{}, children...)
+}
@@ -0,0 +1,2585 @@
+// Package attributes provides the attributes of SVG 2 elements, including
+// the presentation attributes. Attributes shared with HTML, such as id,
+// class, style and aria-*, are provided by htmlgo/attributes.
+//
+// The data of paint and reference attributes, i.e. fill, stroke, clip-path,
+// mask, filter and marker-*, is filtered like html/template filters URLs:
+// strings may only contain colors, keywords and references to fragments of
+// the document, e.g. url(#gradient). Other strings are replaced by
+// ZgotmplZ. Likewise, attributeName must not name an attribute which would
+// be filtered, e.g. href or an event handler.
+package attributes
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// unsafeValue replaces filtered data, as html/template does
+const unsafeValue = "ZgotmplZ"
+
+// filterPaint filters string data which is not a paint or reference value
+func filterPaint(data interface{}) interface{} {
+	s, ok := stringData(data)
+	if !ok || isPaint(s) {
+		return data
+	}
+	return unsafeValue
+}
+
+// stringData returns data as html/template prints it if it is printed as
+// string, i.e. if it is of a string type, a fmt.Stringer or an error, or a
+// pointer to one
+func stringData(data interface{}) (string, bool) {
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		if _, ok := v.Interface().(fmt.Stringer); ok {
+			break
+		}
+		if _, ok := v.Interface().(error); ok {
+			break
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return "", false
+	}
+	switch d := v.Interface().(type) {
+	case fmt.Stringer, error:
+		return fmt.Sprint(d), true
+	}
+	if v.Kind() == reflect.String {
+		return v.String(), true
+	}
+	return "", false
+}
+
+// isPaint reports whether s consists of colors, keywords, numbers and
+// references to fragments, e.g. url(#gradient) red
+func isPaint(s string) bool {
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.ContainsRune(" #%.,()-_+", c):
+		default:
+			return false
+		}
+	}
+	lower := strings.ToLower(s)
+	for i := strings.Index(lower, "url("); i >= 0; i = strings.Index(lower, "url(") {
+		if !strings.HasPrefix(lower[i+len("url("):], "#") {
+			return false
+		}
+		lower = lower[i+len("url("):]
+	}
+	return true
+}
+
+// filterAttributeName filters animations of attributes whose values
+// html/template would filter, as the animated values are not filtered
+func filterAttributeName(data interface{}) interface{} {
+	s, ok := stringData(data)
+	if !ok {
+		return data
+	}
+	name := strings.ToLower(strings.TrimSpace(s))
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		name = name[i+1:]
+	}
+	if name == "href" || name == "src" || name == "style" || strings.HasPrefix(name, "on") {
+		return unsafeValue
+	}
+	return s
+}
+
+// Begin of generated attributes
+
+func Accumulate(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Accumulate"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Accumulate"}}accumulate="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Accumulate"}}accumulate="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Accumulate_(values ...string) a.Attribute {
+	return Accumulate(nil, values...)
+}
+
+func Additive(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Additive"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Additive"}}additive="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Additive"}}additive="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Additive_(values ...string) a.Attribute {
+	return Additive(nil, values...)
+}
+
+func AlignmentBaseline(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "AlignmentBaseline"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AlignmentBaseline"}}alignment-baseline="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AlignmentBaseline"}}alignment-baseline="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func AlignmentBaseline_(values ...string) a.Attribute {
+	return AlignmentBaseline(nil, values...)
+}
+
+func Amplitude(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Amplitude"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Amplitude"}}amplitude="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Amplitude"}}amplitude="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Amplitude_(values ...string) a.Attribute {
+	return Amplitude(nil, values...)
+}
+
+func AttributeName(data interface{}, templs ...string) a.Attribute {
+	data = filterAttributeName(data)
+	attr := a.Attribute{Data: data, Name: "AttributeName"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "AttributeName"}}attributeName="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "AttributeName"}}attributeName="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func AttributeName_(values ...string) a.Attribute {
+	return AttributeName(nil, values...)
+}
+
+func Azimuth(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Azimuth"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Azimuth"}}azimuth="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Azimuth"}}azimuth="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Azimuth_(values ...string) a.Attribute {
+	return Azimuth(nil, values...)
+}
+
+func BaseFrequency(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "BaseFrequency"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "BaseFrequency"}}baseFrequency="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "BaseFrequency"}}baseFrequency="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func BaseFrequency_(values ...string) a.Attribute {
+	return BaseFrequency(nil, values...)
+}
+
+func BaselineShift(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "BaselineShift"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "BaselineShift"}}baseline-shift="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "BaselineShift"}}baseline-shift="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func BaselineShift_(values ...string) a.Attribute {
+	return BaselineShift(nil, values...)
+}
+
+func Begin(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Begin"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Begin"}}begin="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Begin"}}begin="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Begin_(values ...string) a.Attribute {
+	return Begin(nil, values...)
+}
+
+func Bias(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Bias"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Bias"}}bias="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Bias"}}bias="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Bias_(values ...string) a.Attribute {
+	return Bias(nil, values...)
+}
+
+func By(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "By"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "By"}}by="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "By"}}by="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func By_(values ...string) a.Attribute {
+	return By(nil, values...)
+}
+
+func CalcMode(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "CalcMode"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "CalcMode"}}calcMode="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "CalcMode"}}calcMode="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func CalcMode_(values ...string) a.Attribute {
+	return CalcMode(nil, values...)
+}
+
+func ClipPath(data interface{}, templs ...string) a.Attribute {
+	data = filterPaint(data)
+	attr := a.Attribute{Data: data, Name: "ClipPath"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "ClipPath"}}clip-path="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "ClipPath"}}clip-path="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func ClipPath_(values ...string) a.Attribute {
+	return ClipPath(nil, values...)
+}
+
+func ClipRule(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "ClipRule"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "ClipRule"}}clip-rule="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "ClipRule"}}clip-rule="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func ClipRule_(values ...string) a.Attribute {
+	return ClipRule(nil, values...)
+}
+
+func ClipPathUnits(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "ClipPathUnits"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "ClipPathUnits"}}clipPathUnits="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "ClipPathUnits"}}clipPathUnits="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func ClipPathUnits_(values ...string) a.Attribute {
+	return ClipPathUnits(nil, values...)
+}
+
+func Color(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Color"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Color"}}color="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Color"}}color="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Color_(values ...string) a.Attribute {
+	return Color(nil, values...)
+}
+
+func ColorInterpolation(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "ColorInterpolation"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "ColorInterpolation"}}color-interpolation="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "ColorInterpolation"}}color-interpolation="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func ColorInterpolation_(values ...string) a.Attribute {
+	return ColorInterpolation(nil, values...)
+}
+
+func ColorInterpolationFilters(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "ColorInterpolationFilters"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "ColorInterpolationFilters"}}color-interpolation-filters="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "ColorInterpolationFilters"}}color-interpolation-filters="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func ColorInterpolationFilters_(values ...string) a.Attribute {
+	return ColorInterpolationFilters(nil, values...)
+}
+
+func Cursor(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Cursor"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Cursor"}}cursor="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Cursor"}}cursor="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Cursor_(values ...string) a.Attribute {
+	return Cursor(nil, values...)
+}
+
+func Cx(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Cx"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Cx"}}cx="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Cx"}}cx="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Cx_(values ...string) a.Attribute {
+	return Cx(nil, values...)
+}
+
+func Cy(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Cy"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Cy"}}cy="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Cy"}}cy="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Cy_(values ...string) a.Attribute {
+	return Cy(nil, values...)
+}
+
+func D(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "D"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "D"}}d="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "D"}}d="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func D_(values ...string) a.Attribute {
+	return D(nil, values...)
+}
+
+func DiffuseConstant(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "DiffuseConstant"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "DiffuseConstant"}}diffuseConstant="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "DiffuseConstant"}}diffuseConstant="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func DiffuseConstant_(values ...string) a.Attribute {
+	return DiffuseConstant(nil, values...)
+}
+
+func Direction(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Direction"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Direction"}}direction="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Direction"}}direction="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Direction_(values ...string) a.Attribute {
+	return Direction(nil, values...)
+}
+
+func Display(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Display"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Display"}}display="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Display"}}display="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Display_(values ...string) a.Attribute {
+	return Display(nil, values...)
+}
+
+func Divisor(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Divisor"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Divisor"}}divisor="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Divisor"}}divisor="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Divisor_(values ...string) a.Attribute {
+	return Divisor(nil, values...)
+}
+
+func DominantBaseline(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "DominantBaseline"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "DominantBaseline"}}dominant-baseline="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "DominantBaseline"}}dominant-baseline="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func DominantBaseline_(values ...string) a.Attribute {
+	return DominantBaseline(nil, values...)
+}
+
+func Dur(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Dur"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Dur"}}dur="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Dur"}}dur="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Dur_(values ...string) a.Attribute {
+	return Dur(nil, values...)
+}
+
+func Dx(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Dx"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Dx"}}dx="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Dx"}}dx="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Dx_(values ...string) a.Attribute {
+	return Dx(nil, values...)
+}
+
+func Dy(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Dy"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Dy"}}dy="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Dy"}}dy="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Dy_(values ...string) a.Attribute {
+	return Dy(nil, values...)
+}
+
+func EdgeMode(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "EdgeMode"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "EdgeMode"}}edgeMode="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "EdgeMode"}}edgeMode="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func EdgeMode_(values ...string) a.Attribute {
+	return EdgeMode(nil, values...)
+}
+
+func Elevation(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Elevation"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Elevation"}}elevation="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Elevation"}}elevation="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Elevation_(values ...string) a.Attribute {
+	return Elevation(nil, values...)
+}
+
+func End(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "End"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "End"}}end="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "End"}}end="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func End_(values ...string) a.Attribute {
+	return End(nil, values...)
+}
+
+func Exponent(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Exponent"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Exponent"}}exponent="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Exponent"}}exponent="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Exponent_(values ...string) a.Attribute {
+	return Exponent(nil, values...)
+}
+
+func Fill(data interface{}, templs ...string) a.Attribute {
+	data = filterPaint(data)
+	attr := a.Attribute{Data: data, Name: "Fill"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Fill"}}fill="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Fill"}}fill="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Fill_(values ...string) a.Attribute {
+	return Fill(nil, values...)
+}
+
+func FillOpacity(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FillOpacity"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FillOpacity"}}fill-opacity="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FillOpacity"}}fill-opacity="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FillOpacity_(values ...string) a.Attribute {
+	return FillOpacity(nil, values...)
+}
+
+func FillRule(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FillRule"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FillRule"}}fill-rule="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FillRule"}}fill-rule="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FillRule_(values ...string) a.Attribute {
+	return FillRule(nil, values...)
+}
+
+func Filter(data interface{}, templs ...string) a.Attribute {
+	data = filterPaint(data)
+	attr := a.Attribute{Data: data, Name: "Filter"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Filter"}}filter="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Filter"}}filter="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Filter_(values ...string) a.Attribute {
+	return Filter(nil, values...)
+}
+
+func FilterUnits(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FilterUnits"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FilterUnits"}}filterUnits="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FilterUnits"}}filterUnits="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FilterUnits_(values ...string) a.Attribute {
+	return FilterUnits(nil, values...)
+}
+
+func FloodColor(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FloodColor"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FloodColor"}}flood-color="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FloodColor"}}flood-color="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FloodColor_(values ...string) a.Attribute {
+	return FloodColor(nil, values...)
+}
+
+func FloodOpacity(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FloodOpacity"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FloodOpacity"}}flood-opacity="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FloodOpacity"}}flood-opacity="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FloodOpacity_(values ...string) a.Attribute {
+	return FloodOpacity(nil, values...)
+}
+
+func FontFamily(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FontFamily"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FontFamily"}}font-family="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FontFamily"}}font-family="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FontFamily_(values ...string) a.Attribute {
+	return FontFamily(nil, values...)
+}
+
+func FontSize(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FontSize"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FontSize"}}font-size="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FontSize"}}font-size="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FontSize_(values ...string) a.Attribute {
+	return FontSize(nil, values...)
+}
+
+func FontSizeAdjust(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FontSizeAdjust"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FontSizeAdjust"}}font-size-adjust="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FontSizeAdjust"}}font-size-adjust="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FontSizeAdjust_(values ...string) a.Attribute {
+	return FontSizeAdjust(nil, values...)
+}
+
+func FontStretch(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FontStretch"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FontStretch"}}font-stretch="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FontStretch"}}font-stretch="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FontStretch_(values ...string) a.Attribute {
+	return FontStretch(nil, values...)
+}
+
+func FontStyle(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FontStyle"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FontStyle"}}font-style="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FontStyle"}}font-style="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FontStyle_(values ...string) a.Attribute {
+	return FontStyle(nil, values...)
+}
+
+func FontVariant(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FontVariant"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FontVariant"}}font-variant="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FontVariant"}}font-variant="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FontVariant_(values ...string) a.Attribute {
+	return FontVariant(nil, values...)
+}
+
+func FontWeight(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "FontWeight"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "FontWeight"}}font-weight="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "FontWeight"}}font-weight="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func FontWeight_(values ...string) a.Attribute {
+	return FontWeight(nil, values...)
+}
+
+func Fr(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Fr"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Fr"}}fr="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Fr"}}fr="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Fr_(values ...string) a.Attribute {
+	return Fr(nil, values...)
+}
+
+func From(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "From"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "From"}}from="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "From"}}from="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func From_(values ...string) a.Attribute {
+	return From(nil, values...)
+}
+
+func Fx(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Fx"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Fx"}}fx="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Fx"}}fx="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Fx_(values ...string) a.Attribute {
+	return Fx(nil, values...)
+}
+
+func Fy(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Fy"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Fy"}}fy="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Fy"}}fy="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Fy_(values ...string) a.Attribute {
+	return Fy(nil, values...)
+}
+
+func GradientTransform(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "GradientTransform"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "GradientTransform"}}gradientTransform="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "GradientTransform"}}gradientTransform="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func GradientTransform_(values ...string) a.Attribute {
+	return GradientTransform(nil, values...)
+}
+
+func GradientUnits(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "GradientUnits"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "GradientUnits"}}gradientUnits="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "GradientUnits"}}gradientUnits="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func GradientUnits_(values ...string) a.Attribute {
+	return GradientUnits(nil, values...)
+}
+
+func Height(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Height"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Height"}}height="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Height"}}height="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Height_(values ...string) a.Attribute {
+	return Height(nil, values...)
+}
+
+func Href(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Href"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Href"}}href="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Href"}}href="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Href_(values ...string) a.Attribute {
+	return Href(nil, values...)
+}
+
+func ImageRendering(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "ImageRendering"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "ImageRendering"}}image-rendering="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "ImageRendering"}}image-rendering="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func ImageRendering_(values ...string) a.Attribute {
+	return ImageRendering(nil, values...)
+}
+
+func In(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "In"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "In"}}in="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "In"}}in="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func In_(values ...string) a.Attribute {
+	return In(nil, values...)
+}
+
+func In2(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "In2"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "In2"}}in2="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "In2"}}in2="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func In2_(values ...string) a.Attribute {
+	return In2(nil, values...)
+}
+
+func Intercept(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Intercept"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Intercept"}}intercept="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Intercept"}}intercept="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Intercept_(values ...string) a.Attribute {
+	return Intercept(nil, values...)
+}
+
+func K1(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "K1"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "K1"}}k1="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "K1"}}k1="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func K1_(values ...string) a.Attribute {
+	return K1(nil, values...)
+}
+
+func K2(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "K2"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "K2"}}k2="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "K2"}}k2="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func K2_(values ...string) a.Attribute {
+	return K2(nil, values...)
+}
+
+func K3(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "K3"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "K3"}}k3="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "K3"}}k3="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func K3_(values ...string) a.Attribute {
+	return K3(nil, values...)
+}
+
+func K4(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "K4"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "K4"}}k4="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "K4"}}k4="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func K4_(values ...string) a.Attribute {
+	return K4(nil, values...)
+}
+
+func KernelMatrix(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "KernelMatrix"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "KernelMatrix"}}kernelMatrix="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "KernelMatrix"}}kernelMatrix="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func KernelMatrix_(values ...string) a.Attribute {
+	return KernelMatrix(nil, values...)
+}
+
+func KernelUnitLength(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "KernelUnitLength"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "KernelUnitLength"}}kernelUnitLength="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "KernelUnitLength"}}kernelUnitLength="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func KernelUnitLength_(values ...string) a.Attribute {
+	return KernelUnitLength(nil, values...)
+}
+
+func KeyPoints(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "KeyPoints"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "KeyPoints"}}keyPoints="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "KeyPoints"}}keyPoints="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func KeyPoints_(values ...string) a.Attribute {
+	return KeyPoints(nil, values...)
+}
+
+func KeySplines(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "KeySplines"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "KeySplines"}}keySplines="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "KeySplines"}}keySplines="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func KeySplines_(values ...string) a.Attribute {
+	return KeySplines(nil, values...)
+}
+
+func KeyTimes(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "KeyTimes"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "KeyTimes"}}keyTimes="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "KeyTimes"}}keyTimes="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func KeyTimes_(values ...string) a.Attribute {
+	return KeyTimes(nil, values...)
+}
+
+func LengthAdjust(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "LengthAdjust"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "LengthAdjust"}}lengthAdjust="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "LengthAdjust"}}lengthAdjust="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func LengthAdjust_(values ...string) a.Attribute {
+	return LengthAdjust(nil, values...)
+}
+
+func LetterSpacing(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "LetterSpacing"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "LetterSpacing"}}letter-spacing="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "LetterSpacing"}}letter-spacing="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func LetterSpacing_(values ...string) a.Attribute {
+	return LetterSpacing(nil, values...)
+}
+
+func LightingColor(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "LightingColor"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "LightingColor"}}lighting-color="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "LightingColor"}}lighting-color="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func LightingColor_(values ...string) a.Attribute {
+	return LightingColor(nil, values...)
+}
+
+func LimitingConeAngle(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "LimitingConeAngle"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "LimitingConeAngle"}}limitingConeAngle="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "LimitingConeAngle"}}limitingConeAngle="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func LimitingConeAngle_(values ...string) a.Attribute {
+	return LimitingConeAngle(nil, values...)
+}
+
+func MarkerEnd(data interface{}, templs ...string) a.Attribute {
+	data = filterPaint(data)
+	attr := a.Attribute{Data: data, Name: "MarkerEnd"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "MarkerEnd"}}marker-end="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "MarkerEnd"}}marker-end="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func MarkerEnd_(values ...string) a.Attribute {
+	return MarkerEnd(nil, values...)
+}
+
+func MarkerMid(data interface{}, templs ...string) a.Attribute {
+	data = filterPaint(data)
+	attr := a.Attribute{Data: data, Name: "MarkerMid"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "MarkerMid"}}marker-mid="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "MarkerMid"}}marker-mid="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func MarkerMid_(values ...string) a.Attribute {
+	return MarkerMid(nil, values...)
+}
+
+func MarkerStart(data interface{}, templs ...string) a.Attribute {
+	data = filterPaint(data)
+	attr := a.Attribute{Data: data, Name: "MarkerStart"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "MarkerStart"}}marker-start="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "MarkerStart"}}marker-start="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func MarkerStart_(values ...string) a.Attribute {
+	return MarkerStart(nil, values...)
+}
+
+func MarkerHeight(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "MarkerHeight"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "MarkerHeight"}}markerHeight="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "MarkerHeight"}}markerHeight="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func MarkerHeight_(values ...string) a.Attribute {
+	return MarkerHeight(nil, values...)
+}
+
+func MarkerUnits(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "MarkerUnits"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "MarkerUnits"}}markerUnits="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "MarkerUnits"}}markerUnits="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func MarkerUnits_(values ...string) a.Attribute {
+	return MarkerUnits(nil, values...)
+}
+
+func MarkerWidth(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "MarkerWidth"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "MarkerWidth"}}markerWidth="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "MarkerWidth"}}markerWidth="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func MarkerWidth_(values ...string) a.Attribute {
+	return MarkerWidth(nil, values...)
+}
+
+func Mask(data interface{}, templs ...string) a.Attribute {
+	data = filterPaint(data)
+	attr := a.Attribute{Data: data, Name: "Mask"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Mask"}}mask="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Mask"}}mask="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Mask_(values ...string) a.Attribute {
+	return Mask(nil, values...)
+}
+
+func MaskType(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "MaskType"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "MaskType"}}mask-type="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "MaskType"}}mask-type="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func MaskType_(values ...string) a.Attribute {
+	return MaskType(nil, values...)
+}
+
+func MaskContentUnits(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "MaskContentUnits"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "MaskContentUnits"}}maskContentUnits="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "MaskContentUnits"}}maskContentUnits="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func MaskContentUnits_(values ...string) a.Attribute {
+	return MaskContentUnits(nil, values...)
+}
+
+func MaskUnits(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "MaskUnits"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "MaskUnits"}}maskUnits="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "MaskUnits"}}maskUnits="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func MaskUnits_(values ...string) a.Attribute {
+	return MaskUnits(nil, values...)
+}
+
+func Max(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Max"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Max"}}max="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Max"}}max="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Max_(values ...string) a.Attribute {
+	return Max(nil, values...)
+}
+
+func Method(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Method"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Method"}}method="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Method"}}method="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Method_(values ...string) a.Attribute {
+	return Method(nil, values...)
+}
+
+func Min(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Min"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Min"}}min="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Min"}}min="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Min_(values ...string) a.Attribute {
+	return Min(nil, values...)
+}
+
+func Mode(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Mode"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Mode"}}mode="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Mode"}}mode="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Mode_(values ...string) a.Attribute {
+	return Mode(nil, values...)
+}
+
+func NumOctaves(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "NumOctaves"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "NumOctaves"}}numOctaves="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "NumOctaves"}}numOctaves="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func NumOctaves_(values ...string) a.Attribute {
+	return NumOctaves(nil, values...)
+}
+
+func Offset(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Offset"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Offset"}}offset="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Offset"}}offset="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Offset_(values ...string) a.Attribute {
+	return Offset(nil, values...)
+}
+
+func Opacity(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Opacity"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Opacity"}}opacity="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Opacity"}}opacity="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Opacity_(values ...string) a.Attribute {
+	return Opacity(nil, values...)
+}
+
+func Operator(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Operator"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Operator"}}operator="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Operator"}}operator="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Operator_(values ...string) a.Attribute {
+	return Operator(nil, values...)
+}
+
+func Order(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Order"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Order"}}order="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Order"}}order="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Order_(values ...string) a.Attribute {
+	return Order(nil, values...)
+}
+
+func Orient(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Orient"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Orient"}}orient="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Orient"}}orient="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Orient_(values ...string) a.Attribute {
+	return Orient(nil, values...)
+}
+
+func Overflow(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Overflow"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Overflow"}}overflow="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Overflow"}}overflow="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Overflow_(values ...string) a.Attribute {
+	return Overflow(nil, values...)
+}
+
+func PaintOrder(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PaintOrder"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PaintOrder"}}paint-order="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PaintOrder"}}paint-order="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PaintOrder_(values ...string) a.Attribute {
+	return PaintOrder(nil, values...)
+}
+
+func Path(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Path"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Path"}}path="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Path"}}path="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Path_(values ...string) a.Attribute {
+	return Path(nil, values...)
+}
+
+func PathLength(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PathLength"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PathLength"}}pathLength="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PathLength"}}pathLength="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PathLength_(values ...string) a.Attribute {
+	return PathLength(nil, values...)
+}
+
+func PatternContentUnits(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PatternContentUnits"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PatternContentUnits"}}patternContentUnits="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PatternContentUnits"}}patternContentUnits="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PatternContentUnits_(values ...string) a.Attribute {
+	return PatternContentUnits(nil, values...)
+}
+
+func PatternTransform(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PatternTransform"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PatternTransform"}}patternTransform="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PatternTransform"}}patternTransform="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PatternTransform_(values ...string) a.Attribute {
+	return PatternTransform(nil, values...)
+}
+
+func PatternUnits(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PatternUnits"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PatternUnits"}}patternUnits="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PatternUnits"}}patternUnits="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PatternUnits_(values ...string) a.Attribute {
+	return PatternUnits(nil, values...)
+}
+
+func PointerEvents(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PointerEvents"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PointerEvents"}}pointer-events="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PointerEvents"}}pointer-events="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PointerEvents_(values ...string) a.Attribute {
+	return PointerEvents(nil, values...)
+}
+
+func Points(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Points"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Points"}}points="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Points"}}points="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Points_(values ...string) a.Attribute {
+	return Points(nil, values...)
+}
+
+func PointsAtX(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PointsAtX"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PointsAtX"}}pointsAtX="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PointsAtX"}}pointsAtX="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PointsAtX_(values ...string) a.Attribute {
+	return PointsAtX(nil, values...)
+}
+
+func PointsAtY(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PointsAtY"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PointsAtY"}}pointsAtY="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PointsAtY"}}pointsAtY="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PointsAtY_(values ...string) a.Attribute {
+	return PointsAtY(nil, values...)
+}
+
+func PointsAtZ(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PointsAtZ"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PointsAtZ"}}pointsAtZ="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PointsAtZ"}}pointsAtZ="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PointsAtZ_(values ...string) a.Attribute {
+	return PointsAtZ(nil, values...)
+}
+
+func PreserveAlpha(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PreserveAlpha"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PreserveAlpha"}}preserveAlpha="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PreserveAlpha"}}preserveAlpha="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PreserveAlpha_(values ...string) a.Attribute {
+	return PreserveAlpha(nil, values...)
+}
+
+func PreserveAspectRatio(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PreserveAspectRatio"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PreserveAspectRatio"}}preserveAspectRatio="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PreserveAspectRatio"}}preserveAspectRatio="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PreserveAspectRatio_(values ...string) a.Attribute {
+	return PreserveAspectRatio(nil, values...)
+}
+
+func PrimitiveUnits(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "PrimitiveUnits"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "PrimitiveUnits"}}primitiveUnits="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "PrimitiveUnits"}}primitiveUnits="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func PrimitiveUnits_(values ...string) a.Attribute {
+	return PrimitiveUnits(nil, values...)
+}
+
+func R(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "R"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "R"}}r="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "R"}}r="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func R_(values ...string) a.Attribute {
+	return R(nil, values...)
+}
+
+func Radius(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Radius"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Radius"}}radius="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Radius"}}radius="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Radius_(values ...string) a.Attribute {
+	return Radius(nil, values...)
+}
+
+func RefX(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "RefX"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "RefX"}}refX="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "RefX"}}refX="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func RefX_(values ...string) a.Attribute {
+	return RefX(nil, values...)
+}
+
+func RefY(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "RefY"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "RefY"}}refY="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "RefY"}}refY="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func RefY_(values ...string) a.Attribute {
+	return RefY(nil, values...)
+}
+
+func RepeatCount(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "RepeatCount"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "RepeatCount"}}repeatCount="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "RepeatCount"}}repeatCount="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func RepeatCount_(values ...string) a.Attribute {
+	return RepeatCount(nil, values...)
+}
+
+func RepeatDur(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "RepeatDur"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "RepeatDur"}}repeatDur="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "RepeatDur"}}repeatDur="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func RepeatDur_(values ...string) a.Attribute {
+	return RepeatDur(nil, values...)
+}
+
+func RequiredExtensions(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "RequiredExtensions"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "RequiredExtensions"}}requiredExtensions="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "RequiredExtensions"}}requiredExtensions="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func RequiredExtensions_(values ...string) a.Attribute {
+	return RequiredExtensions(nil, values...)
+}
+
+func Restart(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Restart"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Restart"}}restart="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Restart"}}restart="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Restart_(values ...string) a.Attribute {
+	return Restart(nil, values...)
+}
+
+func Result(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Result"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Result"}}result="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Result"}}result="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Result_(values ...string) a.Attribute {
+	return Result(nil, values...)
+}
+
+func Rotate(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Rotate"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Rotate"}}rotate="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Rotate"}}rotate="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Rotate_(values ...string) a.Attribute {
+	return Rotate(nil, values...)
+}
+
+func Rx(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Rx"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Rx"}}rx="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Rx"}}rx="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Rx_(values ...string) a.Attribute {
+	return Rx(nil, values...)
+}
+
+func Ry(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Ry"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Ry"}}ry="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Ry"}}ry="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Ry_(values ...string) a.Attribute {
+	return Ry(nil, values...)
+}
+
+func Scale(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Scale"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Scale"}}scale="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Scale"}}scale="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Scale_(values ...string) a.Attribute {
+	return Scale(nil, values...)
+}
+
+func Seed(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Seed"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Seed"}}seed="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Seed"}}seed="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Seed_(values ...string) a.Attribute {
+	return Seed(nil, values...)
+}
+
+func ShapeRendering(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "ShapeRendering"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "ShapeRendering"}}shape-rendering="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "ShapeRendering"}}shape-rendering="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func ShapeRendering_(values ...string) a.Attribute {
+	return ShapeRendering(nil, values...)
+}
+
+func Side(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Side"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Side"}}side="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Side"}}side="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Side_(values ...string) a.Attribute {
+	return Side(nil, values...)
+}
+
+func Slope(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Slope"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Slope"}}slope="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Slope"}}slope="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Slope_(values ...string) a.Attribute {
+	return Slope(nil, values...)
+}
+
+func Spacing(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Spacing"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Spacing"}}spacing="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Spacing"}}spacing="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Spacing_(values ...string) a.Attribute {
+	return Spacing(nil, values...)
+}
+
+func SpecularConstant(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "SpecularConstant"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "SpecularConstant"}}specularConstant="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "SpecularConstant"}}specularConstant="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func SpecularConstant_(values ...string) a.Attribute {
+	return SpecularConstant(nil, values...)
+}
+
+func SpecularExponent(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "SpecularExponent"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "SpecularExponent"}}specularExponent="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "SpecularExponent"}}specularExponent="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func SpecularExponent_(values ...string) a.Attribute {
+	return SpecularExponent(nil, values...)
+}
+
+func SpreadMethod(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "SpreadMethod"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "SpreadMethod"}}spreadMethod="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "SpreadMethod"}}spreadMethod="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func SpreadMethod_(values ...string) a.Attribute {
+	return SpreadMethod(nil, values...)
+}
+
+func StartOffset(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StartOffset"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StartOffset"}}startOffset="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StartOffset"}}startOffset="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StartOffset_(values ...string) a.Attribute {
+	return StartOffset(nil, values...)
+}
+
+func StdDeviation(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StdDeviation"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StdDeviation"}}stdDeviation="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StdDeviation"}}stdDeviation="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StdDeviation_(values ...string) a.Attribute {
+	return StdDeviation(nil, values...)
+}
+
+func StitchTiles(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StitchTiles"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StitchTiles"}}stitchTiles="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StitchTiles"}}stitchTiles="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StitchTiles_(values ...string) a.Attribute {
+	return StitchTiles(nil, values...)
+}
+
+func StopColor(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StopColor"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StopColor"}}stop-color="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StopColor"}}stop-color="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StopColor_(values ...string) a.Attribute {
+	return StopColor(nil, values...)
+}
+
+func StopOpacity(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StopOpacity"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StopOpacity"}}stop-opacity="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StopOpacity"}}stop-opacity="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StopOpacity_(values ...string) a.Attribute {
+	return StopOpacity(nil, values...)
+}
+
+func Stroke(data interface{}, templs ...string) a.Attribute {
+	data = filterPaint(data)
+	attr := a.Attribute{Data: data, Name: "Stroke"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Stroke"}}stroke="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Stroke"}}stroke="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Stroke_(values ...string) a.Attribute {
+	return Stroke(nil, values...)
+}
+
+func StrokeDasharray(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StrokeDasharray"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StrokeDasharray"}}stroke-dasharray="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StrokeDasharray"}}stroke-dasharray="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StrokeDasharray_(values ...string) a.Attribute {
+	return StrokeDasharray(nil, values...)
+}
+
+func StrokeDashoffset(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StrokeDashoffset"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StrokeDashoffset"}}stroke-dashoffset="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StrokeDashoffset"}}stroke-dashoffset="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StrokeDashoffset_(values ...string) a.Attribute {
+	return StrokeDashoffset(nil, values...)
+}
+
+func StrokeLinecap(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StrokeLinecap"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StrokeLinecap"}}stroke-linecap="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StrokeLinecap"}}stroke-linecap="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StrokeLinecap_(values ...string) a.Attribute {
+	return StrokeLinecap(nil, values...)
+}
+
+func StrokeLinejoin(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StrokeLinejoin"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StrokeLinejoin"}}stroke-linejoin="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StrokeLinejoin"}}stroke-linejoin="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StrokeLinejoin_(values ...string) a.Attribute {
+	return StrokeLinejoin(nil, values...)
+}
+
+func StrokeMiterlimit(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StrokeMiterlimit"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StrokeMiterlimit"}}stroke-miterlimit="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StrokeMiterlimit"}}stroke-miterlimit="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StrokeMiterlimit_(values ...string) a.Attribute {
+	return StrokeMiterlimit(nil, values...)
+}
+
+func StrokeOpacity(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StrokeOpacity"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StrokeOpacity"}}stroke-opacity="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StrokeOpacity"}}stroke-opacity="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StrokeOpacity_(values ...string) a.Attribute {
+	return StrokeOpacity(nil, values...)
+}
+
+func StrokeWidth(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "StrokeWidth"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "StrokeWidth"}}stroke-width="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "StrokeWidth"}}stroke-width="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func StrokeWidth_(values ...string) a.Attribute {
+	return StrokeWidth(nil, values...)
+}
+
+func SurfaceScale(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "SurfaceScale"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "SurfaceScale"}}surfaceScale="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "SurfaceScale"}}surfaceScale="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func SurfaceScale_(values ...string) a.Attribute {
+	return SurfaceScale(nil, values...)
+}
+
+func SystemLanguage(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "SystemLanguage"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "SystemLanguage"}}systemLanguage="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "SystemLanguage"}}systemLanguage="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func SystemLanguage_(values ...string) a.Attribute {
+	return SystemLanguage(nil, values...)
+}
+
+func TableValues(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "TableValues"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "TableValues"}}tableValues="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "TableValues"}}tableValues="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func TableValues_(values ...string) a.Attribute {
+	return TableValues(nil, values...)
+}
+
+func TargetX(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "TargetX"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "TargetX"}}targetX="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "TargetX"}}targetX="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func TargetX_(values ...string) a.Attribute {
+	return TargetX(nil, values...)
+}
+
+func TargetY(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "TargetY"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "TargetY"}}targetY="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "TargetY"}}targetY="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func TargetY_(values ...string) a.Attribute {
+	return TargetY(nil, values...)
+}
+
+func TextAnchor(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "TextAnchor"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "TextAnchor"}}text-anchor="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "TextAnchor"}}text-anchor="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func TextAnchor_(values ...string) a.Attribute {
+	return TextAnchor(nil, values...)
+}
+
+func TextDecoration(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "TextDecoration"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "TextDecoration"}}text-decoration="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "TextDecoration"}}text-decoration="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func TextDecoration_(values ...string) a.Attribute {
+	return TextDecoration(nil, values...)
+}
+
+func TextRendering(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "TextRendering"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "TextRendering"}}text-rendering="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "TextRendering"}}text-rendering="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func TextRendering_(values ...string) a.Attribute {
+	return TextRendering(nil, values...)
+}
+
+func TextLength(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "TextLength"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "TextLength"}}textLength="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "TextLength"}}textLength="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func TextLength_(values ...string) a.Attribute {
+	return TextLength(nil, values...)
+}
+
+func To(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "To"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "To"}}to="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "To"}}to="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func To_(values ...string) a.Attribute {
+	return To(nil, values...)
+}
+
+func Transform(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Transform"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Transform"}}transform="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Transform"}}transform="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Transform_(values ...string) a.Attribute {
+	return Transform(nil, values...)
+}
+
+func TransformOrigin(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "TransformOrigin"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "TransformOrigin"}}transform-origin="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "TransformOrigin"}}transform-origin="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func TransformOrigin_(values ...string) a.Attribute {
+	return TransformOrigin(nil, values...)
+}
+
+func Type(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Type"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Type"}}type="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Type"}}type="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Type_(values ...string) a.Attribute {
+	return Type(nil, values...)
+}
+
+func UnicodeBidi(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "UnicodeBidi"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "UnicodeBidi"}}unicode-bidi="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "UnicodeBidi"}}unicode-bidi="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func UnicodeBidi_(values ...string) a.Attribute {
+	return UnicodeBidi(nil, values...)
+}
+
+func Values(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Values"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Values"}}values="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Values"}}values="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Values_(values ...string) a.Attribute {
+	return Values(nil, values...)
+}
+
+func VectorEffect(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "VectorEffect"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "VectorEffect"}}vector-effect="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "VectorEffect"}}vector-effect="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func VectorEffect_(values ...string) a.Attribute {
+	return VectorEffect(nil, values...)
+}
+
+func ViewBox(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "ViewBox"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "ViewBox"}}viewBox="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "ViewBox"}}viewBox="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func ViewBox_(values ...string) a.Attribute {
+	return ViewBox(nil, values...)
+}
+
+func Visibility(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Visibility"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Visibility"}}visibility="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Visibility"}}visibility="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Visibility_(values ...string) a.Attribute {
+	return Visibility(nil, values...)
+}
+
+func Width(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Width"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Width"}}width="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Width"}}width="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Width_(values ...string) a.Attribute {
+	return Width(nil, values...)
+}
+
+func WordSpacing(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "WordSpacing"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "WordSpacing"}}word-spacing="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "WordSpacing"}}word-spacing="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func WordSpacing_(values ...string) a.Attribute {
+	return WordSpacing(nil, values...)
+}
+
+func WritingMode(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "WritingMode"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "WritingMode"}}writing-mode="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "WritingMode"}}writing-mode="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func WritingMode_(values ...string) a.Attribute {
+	return WritingMode(nil, values...)
+}
+
+func X(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "X"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "X"}}x="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "X"}}x="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func X_(values ...string) a.Attribute {
+	return X(nil, values...)
+}
+
+func X1(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "X1"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "X1"}}x1="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "X1"}}x1="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func X1_(values ...string) a.Attribute {
+	return X1(nil, values...)
+}
+
+func X2(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "X2"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "X2"}}x2="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "X2"}}x2="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func X2_(values ...string) a.Attribute {
+	return X2(nil, values...)
+}
+
+func XChannelSelector(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "XChannelSelector"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "XChannelSelector"}}xChannelSelector="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "XChannelSelector"}}xChannelSelector="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func XChannelSelector_(values ...string) a.Attribute {
+	return XChannelSelector(nil, values...)
+}
+
+func XlinkHref(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "XlinkHref"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "XlinkHref"}}xlink:href="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "XlinkHref"}}xlink:href="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func XlinkHref_(values ...string) a.Attribute {
+	return XlinkHref(nil, values...)
+}
+
+func XlinkTitle(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "XlinkTitle"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "XlinkTitle"}}xlink:title="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "XlinkTitle"}}xlink:title="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func XlinkTitle_(values ...string) a.Attribute {
+	return XlinkTitle(nil, values...)
+}
+
+func XmlSpace(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "XmlSpace"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "XmlSpace"}}xml:space="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "XmlSpace"}}xml:space="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func XmlSpace_(values ...string) a.Attribute {
+	return XmlSpace(nil, values...)
+}
+
+func Xmlns(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Xmlns"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Xmlns"}}xmlns="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Xmlns"}}xmlns="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Xmlns_(values ...string) a.Attribute {
+	return Xmlns(nil, values...)
+}
+
+func XmlnsXlink(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "XmlnsXlink"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "XmlnsXlink"}}xmlns:xlink="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "XmlnsXlink"}}xmlns:xlink="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func XmlnsXlink_(values ...string) a.Attribute {
+	return XmlnsXlink(nil, values...)
+}
+
+func Y(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Y"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Y"}}y="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Y"}}y="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Y_(values ...string) a.Attribute {
+	return Y(nil, values...)
+}
+
+func Y1(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Y1"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Y1"}}y1="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Y1"}}y1="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Y1_(values ...string) a.Attribute {
+	return Y1(nil, values...)
+}
+
+func Y2(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Y2"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Y2"}}y2="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Y2"}}y2="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Y2_(values ...string) a.Attribute {
+	return Y2(nil, values...)
+}
+
+func YChannelSelector(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "YChannelSelector"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "YChannelSelector"}}yChannelSelector="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "YChannelSelector"}}yChannelSelector="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func YChannelSelector_(values ...string) a.Attribute {
+	return YChannelSelector(nil, values...)
+}
+
+func Z(data interface{}, templs ...string) a.Attribute {
+	attr := a.Attribute{Data: data, Name: "Z"}
+	if len(templs) == 0 {
+		attr.Templ = `{{define "Z"}}z="{{.}}"{{end}}`
+	} else {
+		attr.Templ = `{{define "Z"}}z="` + strings.Join(templs, " ") + `"{{end}}`
+	}
+	return attr
+}
+
+func Z_(values ...string) a.Attribute {
+	return Z(nil, values...)
+}
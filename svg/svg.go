@@ -0,0 +1,507 @@
+// Package svg provides the elements of SVG 2 for inline SVG. The elements
+// follow the rules of foreign content, i.e. elements without children are
+// self-closing, and names are case-sensitive, e.g. LinearGradient produces
+// <linearGradient>. Use the attributes of htmlgo/svg/attributes along with
+// the global ones of htmlgo/attributes:
+//
+//	Svg(h.Attr(sa.ViewBox_("0 0 10 10"), a.Class_("icon")),
+//	    Circle(h.Attr(sa.Cx_("5"), sa.Cy_("5"), sa.R_("4"), sa.Fill(color))))
+//
+// The script and style elements of SVG are not provided, use htmlgo's
+// Script and Style instead.
+package svg
+
+import (
+	h "github.com/julvo/htmlgo"
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+// Begin of generated elements
+
+func A(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("a", attrs, children...)
+}
+
+func A_(children ...h.HTML) h.HTML {
+	return A(h.Attr(), children...)
+}
+
+func Animate(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("animate", attrs, children...)
+}
+
+func Animate_(children ...h.HTML) h.HTML {
+	return Animate(h.Attr(), children...)
+}
+
+func AnimateMotion(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("animateMotion", attrs, children...)
+}
+
+func AnimateMotion_(children ...h.HTML) h.HTML {
+	return AnimateMotion(h.Attr(), children...)
+}
+
+func AnimateTransform(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("animateTransform", attrs, children...)
+}
+
+func AnimateTransform_(children ...h.HTML) h.HTML {
+	return AnimateTransform(h.Attr(), children...)
+}
+
+func Circle(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("circle", attrs, children...)
+}
+
+func Circle_(children ...h.HTML) h.HTML {
+	return Circle(h.Attr(), children...)
+}
+
+func ClipPath(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("clipPath", attrs, children...)
+}
+
+func ClipPath_(children ...h.HTML) h.HTML {
+	return ClipPath(h.Attr(), children...)
+}
+
+func Defs(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("defs", attrs, children...)
+}
+
+func Defs_(children ...h.HTML) h.HTML {
+	return Defs(h.Attr(), children...)
+}
+
+func Desc(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("desc", attrs, children...)
+}
+
+func Desc_(children ...h.HTML) h.HTML {
+	return Desc(h.Attr(), children...)
+}
+
+func Ellipse(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("ellipse", attrs, children...)
+}
+
+func Ellipse_(children ...h.HTML) h.HTML {
+	return Ellipse(h.Attr(), children...)
+}
+
+func FeBlend(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feBlend", attrs, children...)
+}
+
+func FeBlend_(children ...h.HTML) h.HTML {
+	return FeBlend(h.Attr(), children...)
+}
+
+func FeColorMatrix(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feColorMatrix", attrs, children...)
+}
+
+func FeColorMatrix_(children ...h.HTML) h.HTML {
+	return FeColorMatrix(h.Attr(), children...)
+}
+
+func FeComponentTransfer(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feComponentTransfer", attrs, children...)
+}
+
+func FeComponentTransfer_(children ...h.HTML) h.HTML {
+	return FeComponentTransfer(h.Attr(), children...)
+}
+
+func FeComposite(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feComposite", attrs, children...)
+}
+
+func FeComposite_(children ...h.HTML) h.HTML {
+	return FeComposite(h.Attr(), children...)
+}
+
+func FeConvolveMatrix(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feConvolveMatrix", attrs, children...)
+}
+
+func FeConvolveMatrix_(children ...h.HTML) h.HTML {
+	return FeConvolveMatrix(h.Attr(), children...)
+}
+
+func FeDiffuseLighting(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feDiffuseLighting", attrs, children...)
+}
+
+func FeDiffuseLighting_(children ...h.HTML) h.HTML {
+	return FeDiffuseLighting(h.Attr(), children...)
+}
+
+func FeDisplacementMap(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feDisplacementMap", attrs, children...)
+}
+
+func FeDisplacementMap_(children ...h.HTML) h.HTML {
+	return FeDisplacementMap(h.Attr(), children...)
+}
+
+func FeDistantLight(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feDistantLight", attrs, children...)
+}
+
+func FeDistantLight_(children ...h.HTML) h.HTML {
+	return FeDistantLight(h.Attr(), children...)
+}
+
+func FeDropShadow(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feDropShadow", attrs, children...)
+}
+
+func FeDropShadow_(children ...h.HTML) h.HTML {
+	return FeDropShadow(h.Attr(), children...)
+}
+
+func FeFlood(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feFlood", attrs, children...)
+}
+
+func FeFlood_(children ...h.HTML) h.HTML {
+	return FeFlood(h.Attr(), children...)
+}
+
+func FeFuncA(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feFuncA", attrs, children...)
+}
+
+func FeFuncA_(children ...h.HTML) h.HTML {
+	return FeFuncA(h.Attr(), children...)
+}
+
+func FeFuncB(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feFuncB", attrs, children...)
+}
+
+func FeFuncB_(children ...h.HTML) h.HTML {
+	return FeFuncB(h.Attr(), children...)
+}
+
+func FeFuncG(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feFuncG", attrs, children...)
+}
+
+func FeFuncG_(children ...h.HTML) h.HTML {
+	return FeFuncG(h.Attr(), children...)
+}
+
+func FeFuncR(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feFuncR", attrs, children...)
+}
+
+func FeFuncR_(children ...h.HTML) h.HTML {
+	return FeFuncR(h.Attr(), children...)
+}
+
+func FeGaussianBlur(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feGaussianBlur", attrs, children...)
+}
+
+func FeGaussianBlur_(children ...h.HTML) h.HTML {
+	return FeGaussianBlur(h.Attr(), children...)
+}
+
+func FeImage(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feImage", attrs, children...)
+}
+
+func FeImage_(children ...h.HTML) h.HTML {
+	return FeImage(h.Attr(), children...)
+}
+
+func FeMerge(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feMerge", attrs, children...)
+}
+
+func FeMerge_(children ...h.HTML) h.HTML {
+	return FeMerge(h.Attr(), children...)
+}
+
+func FeMergeNode(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feMergeNode", attrs, children...)
+}
+
+func FeMergeNode_(children ...h.HTML) h.HTML {
+	return FeMergeNode(h.Attr(), children...)
+}
+
+func FeMorphology(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feMorphology", attrs, children...)
+}
+
+func FeMorphology_(children ...h.HTML) h.HTML {
+	return FeMorphology(h.Attr(), children...)
+}
+
+func FeOffset(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feOffset", attrs, children...)
+}
+
+func FeOffset_(children ...h.HTML) h.HTML {
+	return FeOffset(h.Attr(), children...)
+}
+
+func FePointLight(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("fePointLight", attrs, children...)
+}
+
+func FePointLight_(children ...h.HTML) h.HTML {
+	return FePointLight(h.Attr(), children...)
+}
+
+func FeSpecularLighting(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feSpecularLighting", attrs, children...)
+}
+
+func FeSpecularLighting_(children ...h.HTML) h.HTML {
+	return FeSpecularLighting(h.Attr(), children...)
+}
+
+func FeSpotLight(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feSpotLight", attrs, children...)
+}
+
+func FeSpotLight_(children ...h.HTML) h.HTML {
+	return FeSpotLight(h.Attr(), children...)
+}
+
+func FeTile(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feTile", attrs, children...)
+}
+
+func FeTile_(children ...h.HTML) h.HTML {
+	return FeTile(h.Attr(), children...)
+}
+
+func FeTurbulence(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("feTurbulence", attrs, children...)
+}
+
+func FeTurbulence_(children ...h.HTML) h.HTML {
+	return FeTurbulence(h.Attr(), children...)
+}
+
+func Filter(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("filter", attrs, children...)
+}
+
+func Filter_(children ...h.HTML) h.HTML {
+	return Filter(h.Attr(), children...)
+}
+
+func ForeignObject(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("foreignObject", attrs, children...)
+}
+
+func ForeignObject_(children ...h.HTML) h.HTML {
+	return ForeignObject(h.Attr(), children...)
+}
+
+func G(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("g", attrs, children...)
+}
+
+func G_(children ...h.HTML) h.HTML {
+	return G(h.Attr(), children...)
+}
+
+func Image(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("image", attrs, children...)
+}
+
+func Image_(children ...h.HTML) h.HTML {
+	return Image(h.Attr(), children...)
+}
+
+func Line(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("line", attrs, children...)
+}
+
+func Line_(children ...h.HTML) h.HTML {
+	return Line(h.Attr(), children...)
+}
+
+func LinearGradient(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("linearGradient", attrs, children...)
+}
+
+func LinearGradient_(children ...h.HTML) h.HTML {
+	return LinearGradient(h.Attr(), children...)
+}
+
+func Marker(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("marker", attrs, children...)
+}
+
+func Marker_(children ...h.HTML) h.HTML {
+	return Marker(h.Attr(), children...)
+}
+
+func Mask(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mask", attrs, children...)
+}
+
+func Mask_(children ...h.HTML) h.HTML {
+	return Mask(h.Attr(), children...)
+}
+
+func Metadata(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("metadata", attrs, children...)
+}
+
+func Metadata_(children ...h.HTML) h.HTML {
+	return Metadata(h.Attr(), children...)
+}
+
+func Mpath(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("mpath", attrs, children...)
+}
+
+func Mpath_(children ...h.HTML) h.HTML {
+	return Mpath(h.Attr(), children...)
+}
+
+func Path(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("path", attrs, children...)
+}
+
+func Path_(children ...h.HTML) h.HTML {
+	return Path(h.Attr(), children...)
+}
+
+func Pattern(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("pattern", attrs, children...)
+}
+
+func Pattern_(children ...h.HTML) h.HTML {
+	return Pattern(h.Attr(), children...)
+}
+
+func Polygon(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("polygon", attrs, children...)
+}
+
+func Polygon_(children ...h.HTML) h.HTML {
+	return Polygon(h.Attr(), children...)
+}
+
+func Polyline(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("polyline", attrs, children...)
+}
+
+func Polyline_(children ...h.HTML) h.HTML {
+	return Polyline(h.Attr(), children...)
+}
+
+func RadialGradient(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("radialGradient", attrs, children...)
+}
+
+func RadialGradient_(children ...h.HTML) h.HTML {
+	return RadialGradient(h.Attr(), children...)
+}
+
+func Rect(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("rect", attrs, children...)
+}
+
+func Rect_(children ...h.HTML) h.HTML {
+	return Rect(h.Attr(), children...)
+}
+
+func Set(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("set", attrs, children...)
+}
+
+func Set_(children ...h.HTML) h.HTML {
+	return Set(h.Attr(), children...)
+}
+
+func Stop(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("stop", attrs, children...)
+}
+
+func Stop_(children ...h.HTML) h.HTML {
+	return Stop(h.Attr(), children...)
+}
+
+func Svg(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("svg", attrs, children...)
+}
+
+func Svg_(children ...h.HTML) h.HTML {
+	return Svg(h.Attr(), children...)
+}
+
+func Switch(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("switch", attrs, children...)
+}
+
+func Switch_(children ...h.HTML) h.HTML {
+	return Switch(h.Attr(), children...)
+}
+
+func Symbol(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("symbol", attrs, children...)
+}
+
+func Symbol_(children ...h.HTML) h.HTML {
+	return Symbol(h.Attr(), children...)
+}
+
+func Text(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("text", attrs, children...)
+}
+
+func Text_(children ...h.HTML) h.HTML {
+	return Text(h.Attr(), children...)
+}
+
+func TextPath(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("textPath", attrs, children...)
+}
+
+func TextPath_(children ...h.HTML) h.HTML {
+	return TextPath(h.Attr(), children...)
+}
+
+func Title(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("title", attrs, children...)
+}
+
+func Title_(children ...h.HTML) h.HTML {
+	return Title(h.Attr(), children...)
+}
+
+func Tspan(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("tspan", attrs, children...)
+}
+
+func Tspan_(children ...h.HTML) h.HTML {
+	return Tspan(h.Attr(), children...)
+}
+
+func Use(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("use", attrs, children...)
+}
+
+func Use_(children ...h.HTML) h.HTML {
+	return Use(h.Attr(), children...)
+}
+
+func View(attrs []a.Attribute, children ...h.HTML) h.HTML {
+	return h.ForeignElement("view", attrs, children...)
+}
+
+func View_(children ...h.HTML) h.HTML {
+	return View(h.Attr(), children...)
+}
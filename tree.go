@@ -0,0 +1,211 @@
+package htmlgo
+
+import (
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+type nodeKind int
+
+const (
+	elementNode nodeKind = iota
+	textNode
+	commentNode
+	cdataNode
+	doctypeNode
+)
+
+// node is a node of the tree parsed from HTML produced by this package.
+// Text, comments, CDATA sections and doctypes keep their source in data,
+// including delimiters, while elements keep their tag name as written.
+type node struct {
+	kind        nodeKind
+	data        string
+	attrs       []a.Rendered
+	foreign     bool
+	selfClosing bool
+	children    []*node
+}
+
+// name returns the lower case tag name of an element
+func (n *node) name() string {
+	return strings.ToLower(n.data)
+}
+
+// parse builds a tree from HTML, which is expected to be well-formed, as
+// produced by this package. Unlike a browser, it does not correct the
+// nesting of elements, and end tags which match no open element are
+// dropped.
+func parse(s string) *node {
+	root := &node{}
+	stack := []*node{root}
+	for s != "" {
+		top := stack[len(stack)-1]
+		var n *node
+		switch {
+		case strings.HasPrefix(s, "<!--"):
+			end := skipPast(s, "-->")
+			n, s = &node{kind: commentNode, data: s[:end]}, s[end:]
+		case strings.HasPrefix(s, "<![CDATA["):
+			end := skipPast(s, "]]>")
+			n, s = &node{kind: cdataNode, data: s[:end]}, s[end:]
+		case strings.HasPrefix(s, "<!") || strings.HasPrefix(s, "<?"):
+			end := skipPast(s, ">")
+			n, s = &node{kind: doctypeNode, data: s[:end]}, s[end:]
+		case endTagName(s) != "":
+			name := endTagName(s)
+			s = s[skipPast(s, ">"):]
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].name() == name {
+					stack = stack[:i]
+					break
+				}
+			}
+			continue
+		case startTagName(s) != "":
+			n, s = parseStartTag(s)
+			n.foreign = (top.foreign && top.name() != "foreignobject") ||
+				n.name() == "svg" || n.name() == "math" || declaresNamespace(n)
+		default:
+			end := nextTag(s)
+			n, s = &node{kind: textNode, data: s[:end]}, s[end:]
+		}
+		top.children = append(top.children, n)
+
+		if n.kind != elementNode || n.selfClosing || (!n.foreign && voidTags[n.name()]) {
+			continue
+		}
+		if !n.foreign && (rawTextTags[n.name()] || escapableRawTextTags[n.name()]) {
+			end := strings.Index(strings.ToLower(s), "</"+n.name())
+			if end < 0 {
+				end = len(s)
+			}
+			if end > 0 {
+				n.children = append(n.children, &node{kind: textNode, data: s[:end]})
+			}
+			s = s[end:]
+			s = s[skipPast(s, ">"):]
+			continue
+		}
+		stack = append(stack, n)
+	}
+	return root
+}
+
+// WalkElements calls fn with the tag name and the attributes of each element
+// of h in document order, as parsed by the Renderer, so that text, comments
+// and the contents of scripts and styles, which may look like tags, are
+// skipped. Attribute values are escaped, as in Rendered.
+func WalkElements(h HTML, fn func(tag string, attrs []a.Rendered)) {
+	walk(parse(string(h)), fn)
+}
+
+func walk(n *node, fn func(tag string, attrs []a.Rendered)) {
+	for _, c := range n.children {
+		if c.kind == elementNode {
+			fn(c.data, c.attrs)
+			walk(c, fn)
+		}
+	}
+}
+
+// declaresNamespace reports whether n declares a namespace other than the
+// one of HTML, e.g. the root element of an Atom feed, so that its contents
+// are parsed as XML
+func declaresNamespace(n *node) bool {
+	if n.name() == "html" {
+		return false
+	}
+	for _, attr := range n.attrs {
+		if (attr.Name == "xmlns" && attr.Value != xhtmlNamespace) || strings.HasPrefix(attr.Name, "xmlns:") {
+			return true
+		}
+	}
+	return false
+}
+
+// parseStartTag parses the start tag at the start of s and returns the
+// element and the remainder of s
+func parseStartTag(s string) (*node, string) {
+	n := &node{kind: elementNode}
+	i := 1
+	for i < len(s) && !isSpace(s[i]) && s[i] != '>' && s[i] != '/' {
+		i++
+	}
+	n.data = s[1:i]
+	for i < len(s) {
+		switch {
+		case isSpace(s[i]):
+			i++
+			continue
+		case s[i] == '>':
+			return n, s[i+1:]
+		case strings.HasPrefix(s[i:], "/>"):
+			n.selfClosing = true
+			return n, s[i+2:]
+		}
+		start := i
+		for i < len(s) && !isSpace(s[i]) && s[i] != '=' && s[i] != '>' && !strings.HasPrefix(s[i:], "/>") {
+			i++
+		}
+		if i == start {
+			// a stray slash
+			i++
+			continue
+		}
+		attr := a.Rendered{Name: s[start:i]}
+		if i < len(s) && s[i] == '=' {
+			i++
+			attr.HasValue = true
+			if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+				end := strings.IndexByte(s[i+1:], s[i])
+				if end < 0 {
+					end = len(s) - i - 1
+				}
+				attr.Value = s[i+1 : i+1+end]
+				i += end + 2
+			} else {
+				start = i
+				for i < len(s) && !isSpace(s[i]) && s[i] != '>' {
+					i++
+				}
+				attr.Value = s[start:i]
+			}
+		}
+		n.attrs = append(n.attrs, attr)
+	}
+	return n, ""
+}
+
+// nextTag returns the index of the next tag, comment, CDATA section or
+// doctype in s, or the length of s if there is none. Other less-than signs
+// belong to the text.
+func nextTag(s string) int {
+	i := 0
+	for {
+		j := strings.IndexByte(s[i+1:], '<')
+		if j < 0 {
+			return len(s)
+		}
+		i += j + 1
+		rest := s[i:]
+		if strings.HasPrefix(rest, "<!") || strings.HasPrefix(rest, "<?") ||
+			startTagName(rest) != "" || endTagName(rest) != "" {
+			return i
+		}
+	}
+}
+
+// endTagName returns the lower case name of the end tag at the start of s,
+// or an empty string if s does not start with an end tag
+func endTagName(s string) string {
+	if !strings.HasPrefix(s, "</") {
+		return ""
+	}
+	return startTagName("<" + s[2:])
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\n' || c == '\t' || c == '\r' || c == '\f'
+}
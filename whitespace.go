@@ -0,0 +1,142 @@
+package htmlgo
+
+import (
+	"strings"
+)
+
+// inlineTags lists the elements which are rendered inline by default. Line
+// breaks around and within inline elements render as spaces, so they are
+// not formatted.
+var inlineTags = map[string]bool{
+	"a":        true,
+	"abbr":     true,
+	"b":        true,
+	"bdi":      true,
+	"bdo":      true,
+	"br":       true,
+	"button":   true,
+	"cite":     true,
+	"code":     true,
+	"data":     true,
+	"del":      true,
+	"dfn":      true,
+	"em":       true,
+	"i":        true,
+	"img":      true,
+	"input":    true,
+	"ins":      true,
+	"kbd":      true,
+	"label":    true,
+	"mark":     true,
+	"meter":    true,
+	"output":   true,
+	"progress": true,
+	"q":        true,
+	"rp":       true,
+	"rt":       true,
+	"ruby":     true,
+	"s":        true,
+	"samp":     true,
+	"select":   true,
+	"small":    true,
+	"span":     true,
+	"strong":   true,
+	"sub":      true,
+	"sup":      true,
+	"textarea": true,
+	"time":     true,
+	"u":        true,
+	"var":      true,
+	"wbr":      true,
+	// obsolete inline elements
+	"acronym": true,
+	"big":     true,
+	"blink":   true,
+	"font":    true,
+	"nobr":    true,
+	"strike":  true,
+	"tt":      true,
+}
+
+// preformattedTags lists the elements whose whitespace is kept as is. Their
+// contents are neither indented nor formatted.
+var preformattedTags = map[string]bool{
+	"listing":  true,
+	"pre":      true,
+	"textarea": true,
+}
+
+// rawTextTags lists the elements whose contents are not parsed as HTML
+var rawTextTags = map[string]bool{
+	"script": true,
+	"style":  true,
+}
+
+// escapableRawTextTags lists the elements whose contents are text, in which
+// character references are decoded
+var escapableRawTextTags = map[string]bool{
+	"textarea": true,
+	"title":    true,
+}
+
+// nextPreformatted returns the start and end of the next preformatted or
+// escapable raw text element in s, or -1 if there is none. Comments, CDATA
+// sections and the contents of raw text elements are skipped, as they may
+// contain text which looks like a start tag.
+func nextPreformatted(s string) (int, int) {
+	i := 0
+	for {
+		j := strings.IndexByte(s[i:], '<')
+		if j < 0 {
+			return -1, -1
+		}
+		i += j
+		rest := s[i:]
+		switch {
+		case strings.HasPrefix(rest, "<!--"):
+			i += skipPast(rest, "-->")
+			continue
+		case strings.HasPrefix(rest, "<![CDATA["):
+			i += skipPast(rest, "]]>")
+			continue
+		}
+		tag := startTagName(rest)
+		switch {
+		case preformattedTags[tag] || escapableRawTextTags[tag]:
+			return i, i + skipPast(rest, "</"+tag+">")
+		case rawTextTags[tag]:
+			i += skipPast(rest, "</"+tag)
+		default:
+			i++
+		}
+	}
+}
+
+// skipPast returns the index following the first occurrence of sep in s, or
+// the length of s if sep does not occur
+func skipPast(s, sep string) int {
+	i := strings.Index(s, sep)
+	if i < 0 {
+		return len(s)
+	}
+	return i + len(sep)
+}
+
+// startTagName returns the lower case name of the start tag at the start of
+// s, or an empty string if s does not start with a start tag
+func startTagName(s string) string {
+	if !strings.HasPrefix(s, "<") {
+		return ""
+	}
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', (c >= '0' && c <= '9' || c == '-' || c == ':' || c == '_' || c == '.') && i > 1:
+		case (c == '>' || c == ' ' || c == '/' || c == '\n' || c == '\t') && i > 1:
+			return strings.ToLower(s[1:i])
+		default:
+			return ""
+		}
+	}
+	return ""
+}
@@ -0,0 +1,183 @@
+package htmlgo
+
+import (
+	"html"
+	"strconv"
+	"strings"
+
+	a "github.com/julvo/htmlgo/attributes"
+)
+
+const (
+	xmlDeclaration  = `<?xml version="1.0" encoding="UTF-8"?>`
+	xhtmlNamespace  = "http://www.w3.org/1999/xhtml"
+	svgNamespace    = "http://www.w3.org/2000/svg"
+	mathmlNamespace = "http://www.w3.org/1998/Math/MathML"
+	xlinkNamespace  = "http://www.w3.org/1999/xlink"
+)
+
+// xmlText converts text of HTML to XML. Named character references, which
+// XML does not define except for a few, are replaced by numeric ones, and
+// ampersands and angle brackets which do not start a reference are escaped.
+func xmlText(s string) string {
+	out := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '<':
+			out.WriteString("&lt;")
+		case '>':
+			out.WriteString("&gt;")
+		case '&':
+			ref := characterReference(s[i:])
+			if ref == "" {
+				out.WriteString("&amp;")
+				continue
+			}
+			i += len(ref) - 1
+			switch {
+			case strings.HasPrefix(ref, "&#"), ref == "&amp;", ref == "&lt;", ref == "&gt;", ref == "&quot;", ref == "&apos;":
+				out.WriteString(ref)
+			default:
+				for _, r := range html.UnescapeString(ref) {
+					out.WriteString("&#" + strconv.Itoa(int(r)) + ";")
+				}
+			}
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return out.String()
+}
+
+// characterReference returns the character reference at the start of s,
+// e.g. &nbsp; or &#160;, or an empty string if s does not start with one
+func characterReference(s string) string {
+	end := strings.IndexByte(s, ';')
+	if end < 2 {
+		return ""
+	}
+	name := s[1:end]
+	if strings.HasPrefix(name, "#") {
+		name = strings.TrimPrefix(strings.TrimPrefix(name[1:], "x"), "X")
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return ""
+		}
+	}
+	ref := s[:end+1]
+	if name == "" || html.UnescapeString(ref) == ref {
+		return ""
+	}
+	return ref
+}
+
+// xmlAttribute converts an attribute value of HTML to XML. Whitespace is
+// escaped, as XML parsers replace it by spaces.
+func xmlAttribute(s string) string {
+	return strings.NewReplacer(
+		"\t", "&#9;",
+		"\n", "&#10;",
+		"\r", "&#13;",
+	).Replace(xmlText(s))
+}
+
+// booleanAttributes lists the boolean attributes of HTML, whose presence
+// means true, so that their empty value can be replaced by their name
+var booleanAttributes = map[string]bool{
+	"allowfullscreen":          true,
+	"async":                    true,
+	"autofocus":                true,
+	"autoplay":                 true,
+	"checked":                  true,
+	"controls":                 true,
+	"default":                  true,
+	"defer":                    true,
+	"disabled":                 true,
+	"formnovalidate":           true,
+	"inert":                    true,
+	"ismap":                    true,
+	"itemscope":                true,
+	"loop":                     true,
+	"multiple":                 true,
+	"muted":                    true,
+	"nomodule":                 true,
+	"novalidate":               true,
+	"open":                     true,
+	"playsinline":              true,
+	"readonly":                 true,
+	"required":                 true,
+	"reversed":                 true,
+	"selected":                 true,
+	"shadowrootclonable":       true,
+	"shadowrootdelegatesfocus": true,
+	"shadowrootserializable":   true,
+}
+
+// xmlBooleanAttributes returns attrs with the boolean attributes of HTML
+// which have an empty value, e.g. disabled="", given their name as value
+func xmlBooleanAttributes(attrs []a.Rendered) []a.Rendered {
+	converted := make([]a.Rendered, len(attrs))
+	for i, attr := range attrs {
+		name := strings.ToLower(attr.Name)
+		if booleanAttributes[name] && attr.Value == "" {
+			attr.Value, attr.HasValue = name, true
+		}
+		converted[i] = attr
+	}
+	return converted
+}
+
+// trimLeadingNewline returns a preformatted element without the line break
+// following its start tag, which HTML parsers drop, but XML parsers keep
+func trimLeadingNewline(n *node) *node {
+	if len(n.children) == 0 || n.children[0].kind != textNode || !strings.HasPrefix(n.children[0].data, "\n") {
+		return n
+	}
+	trimmed := *n
+	first := *n.children[0]
+	first.data = first.data[1:]
+	trimmed.children = append([]*node{&first}, n.children[1:]...)
+	return &trimmed
+}
+
+// namespace returns the namespace n declares when serialised as XML, or an
+// empty string
+func namespace(n, parent *node) string {
+	switch {
+	case n.name() == "html" && !n.foreign:
+		return xhtmlNamespace
+	case parent != nil && parent.foreign:
+		return ""
+	case n.name() == "svg":
+		return svgNamespace
+	case n.name() == "math":
+		return mathmlNamespace
+	}
+	return ""
+}
+
+// usesXlink reports whether n or its descendants have xlink attributes
+func usesXlink(n *node) bool {
+	for _, attr := range n.attrs {
+		if strings.HasPrefix(attr.Name, "xlink:") {
+			return true
+		}
+	}
+	for _, c := range n.children {
+		if usesXlink(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAttribute reports whether n has an attribute of the given name
+func hasAttribute(n *node, name string) bool {
+	for _, attr := range n.attrs {
+		if attr.Name == name {
+			return true
+		}
+	}
+	return false
+}